@@ -0,0 +1,24 @@
+package main
+
+import (
+	"os"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/export"
+)
+
+// The atomic-write primitives moved to internal/export with the writers
+// that use them; state and config saves in this package go through these
+// forwarders.
+
+// writeFileAtomic writes data to a temp file in the target directory and
+// renames it into place, so a crash mid-write never leaves a truncated
+// file at the destination.
+func writeFileAtomic(path string, data []byte) error {
+	return export.WriteFileAtomic(path, data)
+}
+
+// withAtomicFile runs write against a temp file and renames it into place
+// only when the writer succeeded.
+func withAtomicFile(path string, write func(*os.File) error) error {
+	return export.WithAtomicFile(path, write)
+}
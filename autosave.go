@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// While a search runs, every validated store and the current stock are
+// flushed to an autosave file every autosaveInterval. A clean finish
+// removes the file, so finding one at startup means the previous process
+// died mid-run — the user is offered its contents back instead of losing
+// hours of work to a panic or OOM kill.
+
+const autosaveFile = "autosave.json"
+
+const autosaveInterval = 30 * time.Second
+
+// autosaveState is what lands in the autosave file.
+type autosaveState struct {
+	SavedAt time.Time     `json:"saved_at"`
+	Mode    string        `json:"mode"`
+	Target  int           `json:"target"`
+	Results []StoreResult `json:"results,omitempty"`
+	Stock   []Crown       `json:"stock,omitempty"`
+}
+
+// autosaver buffers in-flight run state between periodic flushes.
+type autosaver struct {
+	mu      sync.Mutex
+	active  bool
+	mode    string
+	target  int
+	results []StoreResult
+	dirty   bool
+	stop    chan struct{}
+}
+
+// autosave is the process-wide autosaver; one run checkpoints at a time.
+var autosave autosaver
+
+// begin starts checkpointing a run.
+func (a *autosaver) begin(mode string, target int) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.active {
+		return
+	}
+	a.active = true
+	a.mode = mode
+	a.target = target
+	a.results = nil
+	a.dirty = true
+	a.stop = make(chan struct{})
+	go a.loop(a.stop)
+}
+
+func (a *autosaver) loop(stop <-chan struct{}) {
+	ticker := time.NewTicker(autosaveInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			a.flush()
+		case <-stop:
+			return
+		}
+	}
+}
+
+// noteResult buffers one validated store for the next flush.
+func (a *autosaver) noteResult(r StoreResult) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if !a.active {
+		return
+	}
+	a.results = append(a.results, r)
+	a.dirty = true
+}
+
+// flush writes the buffered state; a flush with nothing new is skipped.
+func (a *autosaver) flush() {
+	a.mu.Lock()
+	if !a.active || !a.dirty {
+		a.mu.Unlock()
+		return
+	}
+	state := autosaveState{
+		SavedAt: time.Now(),
+		Mode:    a.mode,
+		Target:  a.target,
+		Results: append([]StoreResult(nil), a.results...),
+	}
+	a.dirty = false
+	a.mu.Unlock()
+
+	state.Stock = store.List()
+	data, err := json.Marshal(state)
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(autosaveFile), data); err != nil {
+		logger.Warn("autosave failed", "err", err)
+	}
+}
+
+// finish stops checkpointing and removes the autosave: the run ended
+// cleanly and its results went through the normal persistence paths.
+func (a *autosaver) finish() {
+	a.mu.Lock()
+	if !a.active {
+		a.mu.Unlock()
+		return
+	}
+	a.active = false
+	close(a.stop)
+	a.results = nil
+	a.mu.Unlock()
+	os.Remove(statePath(autosaveFile))
+}
+
+// offerRecovery runs at startup: a leftover autosave is offered back —
+// stock merged into the store, results exported and browsable. Headless
+// runs only report it and leave the file for an interactive session.
+func offerRecovery() {
+	data, err := os.ReadFile(statePath(autosaveFile))
+	if err != nil {
+		return
+	}
+	var state autosaveState
+	if err := json.Unmarshal(data, &state); err != nil {
+		os.Remove(statePath(autosaveFile))
+		return
+	}
+	fmt.Printf("⚠️  Found autosaved state from %s: %s run, %d stores, %d crowns\n",
+		state.SavedAt.Format("2006-01-02 15:04:05"), state.Mode, len(state.Results), len(state.Stock))
+	if headless {
+		fmt.Println("   Run interactively to recover it.")
+		return
+	}
+	if !promptYes("Recover it? (y/n): ") {
+		if promptYes("Delete the autosave? (y/n): ") {
+			os.Remove(statePath(autosaveFile))
+		}
+		return
+	}
+	if len(state.Stock) > 0 {
+		journalStock("autosave recovery")
+		added, skipped := store.Add(state.Stock...)
+		if added > 0 {
+			fmt.Printf("📦 Restored %d crowns (%d already present)\n", added, skipped)
+			saveStockSnapshot()
+		}
+	}
+	if len(state.Results) > 0 {
+		if file := exportResults(state.Results, "json"); file != "" {
+			fmt.Printf("✅ Recovered %d stores from the interrupted run\n", len(state.Results))
+		}
+		if promptYes("Browse recovered results? (y/n): ") {
+			browseResults(state.Results)
+		}
+	}
+	os.Remove(statePath(autosaveFile))
+	pause()
+}
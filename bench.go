@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+func init() {
+	var benchInput, benchWorkers string
+	var benchSample int
+	benchCmd := &cobra.Command{
+		Use:   "bench",
+		Short: "Validate a fixed workload at several worker counts to pick Threads",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if benchInput == "" {
+				return fmt.Errorf("--input is required")
+			}
+			results, err := LoadResultsJSON(benchInput)
+			if err != nil {
+				return err
+			}
+			if len(results) > benchSample {
+				results = results[:benchSample]
+			}
+			if len(results) == 0 {
+				return fmt.Errorf("no stores in %s", benchInput)
+			}
+			var counts []int
+			for _, w := range strings.Split(benchWorkers, ",") {
+				n, err := strconv.Atoi(strings.TrimSpace(w))
+				if err != nil || n < 1 {
+					return fmt.Errorf("bad worker count %q", w)
+				}
+				counts = append(counts, n)
+			}
+			runBenchmark(results, counts)
+			return nil
+		},
+	}
+	benchCmd.Flags().StringVar(&benchInput, "input", "", "results JSON with stores to validate")
+	benchCmd.Flags().StringVar(&benchWorkers, "workers", "5,10,25,50", "comma-separated worker counts to try")
+	benchCmd.Flags().IntVar(&benchSample, "sample", 50, "cap on stores validated per round")
+	rootCmd.AddCommand(benchCmd)
+}
+
+// runBenchmark validates the same stores once per worker count and prints
+// throughput and error rate for each, so Threads can be tuned to the
+// network and proxies actually in use.
+func runBenchmark(results []StoreResult, counts []int) {
+	fmt.Printf("📊 Benchmarking %d stores\n", len(results))
+	fmt.Printf("   %7s %12s %10s %9s\n", "workers", "stores/sec", "errors", "elapsed")
+	bestWorkers, bestRate := 0, 0.0
+	for _, workers := range counts {
+		if interrupted() {
+			return
+		}
+		// Validate copies: validation mutates its result, and each
+		// round should see the same workload.
+		round := make([]StoreResult, len(results))
+		copy(round, results)
+
+		validator := newStoreValidator(0)
+		jobs := make(chan int)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		errors := 0
+		start := time.Now()
+		for w := 0; w < workers; w++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for i := range jobs {
+					if err := validator.Validate(appCtx, &round[i]); err != nil {
+						mu.Lock()
+						errors++
+						mu.Unlock()
+					}
+				}
+			}()
+		}
+		for i := range round {
+			jobs <- i
+		}
+		close(jobs)
+		wg.Wait()
+
+		elapsed := time.Since(start)
+		rate := float64(len(round)) / elapsed.Seconds()
+		fmt.Printf("   %7d %12.1f %5d/%-4d %9s\n",
+			workers, rate, errors, len(round), elapsed.Round(time.Millisecond))
+		if rate > bestRate {
+			bestRate, bestWorkers = rate, workers
+		}
+	}
+	fmt.Printf("🏁 Best throughput at %d workers — set \"threads\": %d in %s\n",
+		bestWorkers, bestWorkers, configPath())
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Event names published on the in-process bus. The webhook forwarder
+// mirrors all of them off-process, so the names double as the webhook
+// event vocabulary.
+const (
+	evRunStarted     = "run_started"
+	evRunFinished    = "run_finished"
+	evStoreFound     = "store_found"
+	evValidationDone = "validation_done"
+	evStockChanged   = "stock_changed"
+	evStockLow       = "stock_low"
+)
+
+// busEvent is one published event.
+type busEvent struct {
+	Name string
+	Time time.Time
+	Data map[string]interface{}
+}
+
+// eventBus fans events out to in-process subscribers, so the modules that
+// react to a change (dashboard, notifiers, stock checks, webhooks) no
+// longer have to be called by the module that caused it. Handlers run
+// synchronously on the publisher's goroutine and must return quickly;
+// anything slow should hand off to its own goroutine.
+type eventBus struct {
+	mu   sync.Mutex
+	subs map[string][]func(busEvent)
+}
+
+// bus is the process-wide event bus.
+var bus eventBus
+
+// subscribe registers a handler for one event name; "*" receives every
+// event. Subscriptions cannot be removed — standing modules only.
+func (b *eventBus) subscribe(name string, fn func(busEvent)) {
+	b.mu.Lock()
+	if b.subs == nil {
+		b.subs = make(map[string][]func(busEvent))
+	}
+	b.subs[name] = append(b.subs[name], fn)
+	b.mu.Unlock()
+}
+
+// publish delivers the event to the name's subscribers, then the
+// wildcard subscribers. Handlers run outside the bus lock so they may
+// publish themselves.
+func (b *eventBus) publish(name string, data map[string]interface{}) {
+	e := busEvent{Name: name, Time: time.Now(), Data: data}
+	b.mu.Lock()
+	handlers := make([]func(busEvent), 0, len(b.subs[name])+len(b.subs["*"]))
+	handlers = append(handlers, b.subs[name]...)
+	handlers = append(handlers, b.subs["*"]...)
+	b.mu.Unlock()
+	for _, fn := range handlers {
+		fn(e)
+	}
+}
+
+// initEventBus wires the standing subscribers: the webhook forwards
+// every event off-process, the stock-low check watches stock changes,
+// and the dashboard keeps a feed of recent events.
+func initEventBus() {
+	bus.subscribe("*", postEventWebhook)
+	bus.subscribe("*", dashboard.noteEvent)
+	bus.subscribe(evStockChanged, func(busEvent) { checkStockLow() })
+}
@@ -0,0 +1,293 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/validate"
+)
+
+// The discovery engine lives in internal/search and the store checks in
+// internal/validate; the interactive flows keep the historical names via
+// these aliases so they read unchanged.
+type (
+	StoreResult  = search.Result
+	SearchConfig = search.Config
+	SearchStats  = search.Stats
+)
+
+// searchEnv wires the app's cross-cutting services (dry-run gating,
+// request metrics, verbose tracing, structured logging) into the
+// engine's hooks.
+func searchEnv() search.Env {
+	return search.Env{
+		DryRun: dryRunSkip,
+		OnRequest: func(provider string, status int, bytes int64, elapsed time.Duration) {
+			recordRequest(provider, status, bytes, elapsed)
+			if provider != "validator" {
+				countQuery(provider)
+			}
+		},
+		Verbosef: logVerbose,
+		Logger:   logger,
+	}
+}
+
+// newSearchScraper builds the engine scraper from the live config.
+// timeout bounds each page fetch; 0 keeps the 15s default.
+func newSearchScraper(timeout time.Duration) *search.Scraper {
+	headers := config.Headers
+	if headers == nil {
+		headers = defaultConfig().Headers
+	}
+	if timeout <= 0 {
+		timeout = 15 * time.Second
+	}
+	scraper := search.NewScraper(newHTTPClient(timeout), headers, config.HeaderProfiles, searchEnv())
+	scraper.Cache = pageCache{}
+	return scraper
+}
+
+// newStoreValidator builds the store validator: the built-in checks,
+// chained with any validator plugins, behind the verdict cache. timeout
+// bounds each probe; 0 keeps the 10s default.
+func newStoreValidator(timeout time.Duration) search.StoreValidator {
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+	base := validate.NewStore(newHTTPClient(timeout), searchEnv())
+	return cachedValidator{next: withPluginValidators(base)}
+}
+
+func printLiveStats(stats *SearchStats) {
+	if verbosity < verbosityNormal {
+		return
+	}
+	snap := stats.Snapshot()
+	elapsed := time.Since(snap.StartTime).Round(time.Second)
+	fmt.Printf("\r📊 Stores: %d | Queries: %d | Dupes: %d | Errors: %d | Elapsed: %s   ",
+		snap.Stores, snap.Queries, snap.Duplicates, snap.Errors, elapsed)
+}
+
+// cdKeyFinderMode runs the interactive store finder.
+func cdKeyFinderMode() {
+	clearScreen()
+	fmt.Println("═══════════ CD-KEY STORE FINDER ═══════════")
+	target := promptIntDefault("Target number of stores (default 100): ", 100)
+	if target <= 0 {
+		target = 100
+	}
+	recordSession("search", map[string]string{"target": strconv.Itoa(target)})
+	finderRun(target)
+}
+
+// finderRun executes a finder run for the given target and walks through
+// export, notification and browsing. Shared with "Repeat last run".
+func finderRun(target int) {
+	cfg := SearchConfig{
+		MaxWorkers:      config.Threads,
+		Timeout:         15 * time.Second,
+		RateLimitPerSec: 5,
+		TargetStores:    target,
+	}
+	stats := search.NewStats()
+	results := runSearch(cfg, stats)
+	fmt.Printf("🏁 Done: %d stores found (%d duplicates dropped, %d errors)\n",
+		len(results), stats.Duplicates, stats.Errors)
+	if report := formatTopReport(results); report != "" {
+		fmt.Print(report)
+	}
+	if stats.Errors > 0 {
+		fmt.Printf("⚠️  %d errors:", stats.Errors)
+		for class, n := range stats.ErrorsByClass {
+			fmt.Printf(" %s=%d", class, n)
+		}
+		fmt.Println()
+	}
+	exported := promptExportFormats(results)
+	resultStats := CalculateStatistics(results)
+	elapsed := time.Since(stats.StartTime).Round(time.Second)
+	if config.SearchNotify && config.TelegramToken != "" {
+		summary := fmt.Sprintf(
+			"🔍 Search finished\nStores: %d\nWith PayPal: %d\nVerified: %d\nElapsed: %s",
+			resultStats.Total, resultStats.WithPayPal, resultStats.Verified, elapsed)
+		if len(exported) > 0 {
+			summary += "\nExports: " + escapeTelegram(strings.Join(exported, ", "))
+		}
+		notifyChannel("search", summary)
+	}
+	if config.SMTP.enabled() {
+		attachment := ""
+		if len(exported) > 0 {
+			attachment = exported[0]
+		}
+		body := fmt.Sprintf(
+			"CD-key search finished.\n\nStores found: %d\nWith PayPal: %d\nVerified: %d\nElapsed: %s\n",
+			resultStats.Total, resultStats.WithPayPal, resultStats.Verified, elapsed)
+		sendReportEmail("CrownPal: search finished", body, attachment)
+	}
+	metricsFile := outputPath("metrics", "json")
+	if err := saveMetrics(metricsFile); err != nil {
+		fmt.Printf("⚠️  Could not write metrics: %v\n", err)
+	} else {
+		fmt.Printf("📊 Metrics written to %s\n", metricsFile)
+	}
+	recordRun(runRecord{
+		Mode:     "search",
+		Start:    stats.StartTime,
+		Duration: elapsed.String(),
+		Params:   map[string]string{"target": strconv.Itoa(target)},
+		Counts: map[string]int{
+			"stores":     resultStats.Total,
+			"verified":   resultStats.Verified,
+			"duplicates": stats.Duplicates,
+			"errors":     stats.Errors,
+		},
+		Exports: exported,
+		Series:  stats.Series(),
+	})
+	if promptYes("\nBrowse results interactively? (y/n): ") {
+		browseResults(results)
+	}
+	exportFilteredMenu(results)
+	pause()
+}
+
+// runSearch executes the scraping and validation pipeline and returns up
+// to cfg.TargetStores results. It is shared by the interactive mode and
+// the search subcommand; the engine does the work, this wrapper feeds the
+// dashboard, events, telemetry and reports around it.
+func runSearch(cfg SearchConfig, stats *SearchStats) []StoreResult {
+	dashboard.setRun(stats)
+	emitEvent(evRunStarted, map[string]interface{}{
+		"mode":   "cdkey_finder",
+		"target": cfg.TargetStores,
+	})
+
+	autosave.begin("search", cfg.TargetStores)
+	results := search.Run(appCtx, cfg, stats, newSearchScraper(cfg.Timeout), newStoreValidator(cfg.Timeout),
+		func(r *StoreResult) {
+			autosave.noteResult(*r)
+			emitEvent(evValidationDone, map[string]interface{}{
+				"url":      r.URL,
+				"verified": r.Verified,
+			})
+			printLiveStats(stats)
+		})
+	fmt.Println()
+	if interrupted() {
+		if errors.Is(appCtx.Err(), context.DeadlineExceeded) {
+			fmt.Printf("⌛ Time budget reached — keeping %d partial results\n", len(results))
+		} else {
+			fmt.Printf("⚠️  Interrupted — keeping %d partial results\n", len(results))
+		}
+	}
+
+	for _, r := range results {
+		emitEvent(evStoreFound, map[string]interface{}{
+			"name":   r.Name,
+			"url":    r.URL,
+			"source": r.Source,
+		})
+	}
+	emitEvent(evRunFinished, map[string]interface{}{
+		"mode":    "cdkey_finder",
+		"stores":  len(results),
+		"errors":  stats.Errors,
+		"elapsed": time.Since(stats.StartTime).String(),
+	})
+	bumpLifetimeStats(map[string]int{
+		"finder.stores":  len(results),
+		"finder.queries": stats.Queries,
+	})
+	logger.Info("search finished", "stores", len(results), "queries", stats.Queries,
+		"errors", stats.Errors, "elapsed", time.Since(stats.StartTime))
+	countStores(len(results))
+	if kv != nil && len(results) > 0 {
+		fmt.Printf("📊 %d of %d stores are new (not seen in the last 30 days)\n",
+			countNewStores(results), len(results))
+	}
+	for class, n := range stats.ErrorsByClass {
+		countError(class, n)
+	}
+	if league := stats.LeagueTable(); league != "" {
+		fmt.Print(league)
+	}
+	saveErrorReport(stats)
+	persistRunDB("search", stats, results)
+	autosave.finish()
+	dashboard.setResults(results)
+	return results
+}
+
+// exportResults writes results in the requested format and returns the
+// path written, or "" when the export failed.
+func exportResults(results []StoreResult, format string) string {
+	var filename string
+	var err error
+	switch format {
+	case "json":
+		filename = outputPath("stores", "json")
+		err = SaveResultsJSON(results, filename)
+	case "txt":
+		filename = outputPath("stores", "txt")
+		err = SaveResultsTXT(results, filename)
+	case "template":
+		templatePath := config.ExportTemplate
+		if !headless {
+			if answer := promptLine(fmt.Sprintf("Template file (default %s): ", templatePath)); answer != "" {
+				templatePath = answer
+			}
+		}
+		if templatePath == "" {
+			fmt.Println("❌ No template file (set export_template or enter a path)")
+			return ""
+		}
+		filename = templateOutputPath(templatePath)
+		err = SaveResultsTemplate(results, templatePath, filename)
+	case "html":
+		filename = outputPath("stores", "html")
+		err = ExportToHTML(results, filename)
+	case "pdf":
+		filename = outputPath("report", "pdf")
+		err = SaveResultsPDF(results, filename)
+	case "sqlite", "db":
+		filename = filepath.Join(config.OutputDir, "stores.db")
+		err = SaveResultsSQLite(results, filename)
+	case "md", "markdown":
+		filename = outputPath("stores", "md")
+		err = SaveResultsMarkdown(results, filename)
+	case "xlsx":
+		filename = outputPath("stores", "xlsx")
+		err = SaveResultsXLSX(results, filename)
+	case "csv":
+		filename = outputPath("stores", "csv")
+		err = exportCSV(results, filename)
+	case "bookmarks":
+		filename = outputPath("bookmarks", "html")
+		err = SaveResultsBookmarks(results, filename)
+	default:
+		fmt.Printf("❌ Unknown format: %s\n", format)
+		return ""
+	}
+	if err != nil {
+		fmt.Printf("❌ Export failed: %v\n", err)
+		return ""
+	}
+	fmt.Printf("💾 Saved to %s\n", filename)
+	uploadExport(filename)
+	return filename
+}
+
+// exportCSV writes the results as CSV. Kept as a thin wrapper so store
+// names containing commas, quotes or newlines go through encoding/csv's
+// escaping instead of string concatenation.
+func exportCSV(results []StoreResult, filename string) error {
+	return SaveResultsCSV(results, filename)
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/export"
+)
+
+// The filter, statistics and export helpers live in internal/export now;
+// these forwarders keep the historical names the menus and subcommands
+// call, and bind the configured column selection into the tabular writers.
+
+// Statistics summarizes a result set.
+type Statistics = export.Statistics
+
+// FilterByPayPal keeps only stores that accept PayPal.
+func FilterByPayPal(results []StoreResult) []StoreResult {
+	return export.FilterByPayPal(results)
+}
+
+// FilterByConfidence keeps stores at or above the given confidence.
+func FilterByConfidence(results []StoreResult, min float64) []StoreResult {
+	return export.FilterByConfidence(results, min)
+}
+
+// FilterByVerified keeps only verified stores.
+func FilterByVerified(results []StoreResult) []StoreResult {
+	return export.FilterByVerified(results)
+}
+
+// FilterBySource keeps stores discovered by the given source.
+func FilterBySource(results []StoreResult, source string) []StoreResult {
+	return export.FilterBySource(results, source)
+}
+
+// SortByTrustScore returns a copy sorted by trust score, best first.
+func SortByTrustScore(results []StoreResult) []StoreResult {
+	return export.SortByTrustScore(results)
+}
+
+// CalculateStatistics aggregates counts and averages over results.
+func CalculateStatistics(results []StoreResult) Statistics {
+	return export.CalculateStatistics(results)
+}
+
+// SaveResultsJSON writes results as indented JSON.
+func SaveResultsJSON(results []StoreResult, filename string) error {
+	return export.SaveResultsJSON(results, filename)
+}
+
+// LoadResultsJSON reads a result file written by SaveResultsJSON.
+func LoadResultsJSON(filename string) ([]StoreResult, error) {
+	return export.LoadResultsJSON(filename)
+}
+
+// SaveResultsTXT writes a plain-text listing, one store per line, headed
+// by the ranked top-store report.
+func SaveResultsTXT(results []StoreResult, filename string) error {
+	return export.SaveResultsTXT(results, filename)
+}
+
+// SaveResultsCSV writes results using encoding/csv with the configured
+// columns.
+func SaveResultsCSV(results []StoreResult, filename string) error {
+	return export.SaveResultsCSV(results, selectedColumns(), filename)
+}
+
+// SaveResultsMarkdown writes a GitHub-flavored Markdown table sorted by
+// trust score, ready for pasting into issues or wikis.
+func SaveResultsMarkdown(results []StoreResult, filename string) error {
+	return export.SaveResultsMarkdown(results, filename)
+}
+
+// SaveResultsXLSX writes results as an Excel workbook with the configured
+// columns.
+func SaveResultsXLSX(results []StoreResult, filename string) error {
+	return export.SaveResultsXLSX(results, selectedColumns(), filename)
+}
+
+// SaveResultsPDF renders a paginated PDF report.
+func SaveResultsPDF(results []StoreResult, filename string) error {
+	return export.SaveResultsPDF(results, filename)
+}
+
+// SaveResultsBookmarks writes a Netscape bookmarks file browsers can
+// import.
+func SaveResultsBookmarks(results []StoreResult, filename string) error {
+	return export.SaveResultsBookmarks(results, filename)
+}
+
+// SaveResultsSQLite appends the results as a new run into a SQLite
+// database.
+func SaveResultsSQLite(results []StoreResult, filename string) error {
+	return export.SaveResultsSQLite(results, filename)
+}
+
+// ExportToHTML renders the self-contained HTML report.
+func ExportToHTML(results []StoreResult, filename string) error {
+	return export.ExportToHTML(results, filename)
+}
+
+// formatTopReport renders the ranked top-store report.
+func formatTopReport(results []StoreResult) string {
+	return export.FormatTopReport(results)
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"encoding/csv"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestExportCSVEscapesHostileFields(t *testing.T) {
+	results := []StoreResult{
+		{Name: `Keys, "Cheap" & Fast`, URL: "https://a.example", Source: "allkeyshop"},
+		{Name: "Multi\nLine Store", URL: "https://b.example", Source: "ggdeals"},
+		{Name: `Trailing backslash \`, URL: "https://c.example,d", Source: "imported"},
+	}
+
+	path := filepath.Join(t.TempDir(), "out.csv")
+	if err := exportCSV(results, path); err != nil {
+		t.Fatalf("exportCSV: %v", err)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatalf("open: %v", err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		t.Fatalf("reading back the CSV: %v", err)
+	}
+	if len(records) != len(results)+1 {
+		t.Fatalf("got %d records, want %d", len(records), len(results)+1)
+	}
+	for i, r := range results {
+		row := records[i+1]
+		if row[0] != r.Name {
+			t.Errorf("row %d name = %q, want %q", i, row[0], r.Name)
+		}
+		if row[1] != r.URL {
+			t.Errorf("row %d url = %q, want %q", i, row[1], r.URL)
+		}
+	}
+}
@@ -0,0 +1,247 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// rootCmd runs the interactive menu when no subcommand is given; the
+// subcommands make every feature scriptable from cron and CI schedulers.
+var rootCmd = &cobra.Command{
+	Use:   "crownpal",
+	Short: "CD-key store finder, crown stock manager and channel notifier",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		// Subcommands run headless: no prompt may ever block them. The
+		// root command is the only one without a parent; comparing against
+		// rootCmd here would make its initializer refer to itself.
+		headless = cmd.HasParent()
+		if flagQuiet {
+			verbosity = verbosityQuiet
+		} else if flagVerbose {
+			verbosity = verbosityVerbose
+		}
+		if !validWorkspaceName(activeWorkspace) {
+			fmt.Printf("⚠️  Invalid workspace name %q, using the default workspace\n", activeWorkspace)
+			activeWorkspace = ""
+		}
+		if flagMaxDuration > 0 {
+			// Work checks appCtx, so a deadline cancels it exactly like
+			// Ctrl+C: outstanding work stops and partial results are kept.
+			appCtx, appCancel = context.WithTimeout(appCtx, flagMaxDuration)
+		}
+		initLogging()
+		if flagPprof != "" {
+			startPprof(flagPprof)
+		}
+		if flagDashboard != "" {
+			startDashboard(flagDashboard)
+		}
+		loadConfig()
+		openKVCache()
+		initEventBus()
+		loadPlugins()
+		if flagGRPC != "" {
+			if err := startGRPCServer(flagGRPC); err != nil {
+				fmt.Printf("❌ gRPC server: %v\n", err)
+			}
+		}
+		initLocale()
+		initTelemetry()
+		startScheduler()
+		loadConsumedLedger()
+		loadStockSnapshot()
+		if headless {
+			// Interactive startup offers recovery; here just surface it.
+			offerRecovery()
+		}
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		runInteractive()
+	},
+}
+
+// flagQuiet and flagVerbose pick the verbosity level before any work runs.
+var flagQuiet, flagVerbose bool
+
+// flagDryRun makes every network call log what it would have done instead
+// of doing it, for checking configuration and query plans before a long run.
+var flagDryRun bool
+
+// flagMaxDuration time-boxes a run; zero means no budget.
+var flagMaxDuration time.Duration
+
+// flagPprof is the pprof listen address; empty keeps it off.
+var flagPprof string
+
+// flagDashboard is the web dashboard listen address; empty keeps it off.
+var flagDashboard string
+
+// flagGRPC is the gRPC API listen address; empty keeps it off.
+var flagGRPC string
+
+// dryRunSkip reports whether --dry-run is on, logging the request that
+// would have been made.
+func dryRunSkip(what string) bool {
+	if !flagDryRun {
+		return false
+	}
+	fmt.Printf("🔄 DRY RUN: %s\n", what)
+	return true
+}
+
+func init() {
+	rootCmd.PersistentFlags().StringVar(&activeProfile, "profile", os.Getenv("CROWNPAL_PROFILE"),
+		"named config profile to use (reads config.<name>.json)")
+	rootCmd.PersistentFlags().StringVar(&activeWorkspace, "workspace", os.Getenv("CROWNPAL_WORKSPACE"),
+		"named workspace keeping its own config overrides, databases, stock and outputs")
+	rootCmd.PersistentFlags().BoolVarP(&flagQuiet, "quiet", "q", false,
+		"only print summaries, no per-item lines")
+	rootCmd.PersistentFlags().BoolVarP(&flagVerbose, "verbose", "v", false,
+		"print per-request detail")
+	rootCmd.PersistentFlags().BoolVar(&flagDryRun, "dry-run", false,
+		"log network requests instead of making them")
+	rootCmd.PersistentFlags().DurationVar(&flagMaxDuration, "max-duration", 0,
+		"cancel outstanding work after this budget, keeping partial results (e.g. 10m)")
+	rootCmd.PersistentFlags().StringVar(&flagPprof, "pprof", "",
+		"expose net/http/pprof on this address (e.g. localhost:6060)")
+	rootCmd.PersistentFlags().StringVar(&flagDashboard, "dashboard", "",
+		"serve the live web dashboard on this address (e.g. localhost:8080)")
+	rootCmd.PersistentFlags().StringVar(&flagGRPC, "grpc", "",
+		"serve the gRPC API on this address (e.g. localhost:9090)")
+
+	var searchTarget int
+	var searchFormats string
+	searchCmd := &cobra.Command{
+		Use:   "search",
+		Short: "Run the store finder and export the results",
+		Run: func(cmd *cobra.Command, args []string) {
+			cfg := SearchConfig{
+				MaxWorkers:      config.Threads,
+				Timeout:         15 * time.Second,
+				RateLimitPerSec: 5,
+				TargetStores:    searchTarget,
+			}
+			stats := search.NewStats()
+			results := runSearch(cfg, stats)
+			fmt.Printf("🏁 %d stores found in %s (%d duplicates dropped, %d errors)\n",
+				len(results), time.Since(stats.StartTime).Round(time.Second),
+				stats.Duplicates, stats.Errors)
+			var exported []string
+			for _, format := range strings.Split(searchFormats, ",") {
+				if format = strings.TrimSpace(format); format != "" {
+					if file := exportResults(results, format); file != "" {
+						exported = append(exported, file)
+					}
+				}
+			}
+			recordRun(runRecord{
+				Mode:     "search",
+				Start:    stats.StartTime,
+				Duration: time.Since(stats.StartTime).Round(time.Second).String(),
+				Params:   map[string]string{"target": fmt.Sprint(searchTarget)},
+				Counts:   map[string]int{"stores": len(results), "errors": stats.Errors},
+				Exports:  exported,
+				Series:   stats.Series(),
+			})
+		},
+	}
+	searchCmd.Flags().IntVar(&searchTarget, "target", 100, "number of stores to find")
+	searchCmd.Flags().StringVar(&searchFormats, "format", "json", "comma-separated export formats")
+
+	stockCmd := &cobra.Command{
+		Use:   "stock",
+		Short: "Manage crown stock",
+	}
+	var stockAddFile, stockAddPool string
+	stockAddCmd := &cobra.Command{
+		Use:   "add",
+		Short: "Add codes from a file or URL to stock",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if stockAddFile == "" {
+				return fmt.Errorf("--file is required")
+			}
+			data, err := readStockSource(stockAddFile)
+			if err != nil {
+				return err
+			}
+			batch, invalid, err := parseStockCrowns(stockAddFile, data)
+			if err != nil {
+				return err
+			}
+			for i := range batch {
+				batch[i].Pool = stockAddPool
+			}
+			journalStock("cli stock add " + stockAddFile)
+			added, skipped := store.Add(batch...)
+			fmt.Printf("✅ Imported %d crowns (%d duplicates skipped, %d invalid, total %d)\n",
+				added, skipped, invalid, store.Count())
+			saveStockSnapshot()
+			return nil
+		},
+	}
+	stockAddCmd.Flags().StringVar(&stockAddFile, "file", "", `code list or stock export to import ("-" for stdin)`)
+	stockAddCmd.Flags().StringVar(&stockAddPool, "pool", "", "pool to add the codes to")
+	stockCmd.AddCommand(stockAddCmd)
+
+	var exportInput, exportFilter, exportFormat string
+	var exportMinConfidence float64
+	exportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Re-export a saved results file, optionally filtered",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if exportInput == "" {
+				return fmt.Errorf("--input is required")
+			}
+			results, err := LoadResultsJSON(exportInput)
+			if err != nil {
+				return err
+			}
+			switch exportFilter {
+			case "":
+			case "paypal":
+				results = FilterByPayPal(results)
+			case "verified":
+				results = FilterByVerified(results)
+			default:
+				return fmt.Errorf("unknown filter %q (paypal, verified)", exportFilter)
+			}
+			if exportMinConfidence > 0 {
+				results = FilterByConfidence(results, exportMinConfidence)
+			}
+			if len(results) == 0 {
+				return fmt.Errorf("nothing matches the filters")
+			}
+			if exportResults(results, exportFormat) == "" {
+				return fmt.Errorf("export failed")
+			}
+			return nil
+		},
+	}
+	exportCmd.Flags().StringVar(&exportInput, "input", "", "results JSON file to load")
+	exportCmd.Flags().StringVar(&exportFilter, "filter", "", "filter: paypal or verified")
+	exportCmd.Flags().Float64Var(&exportMinConfidence, "min-confidence", 0, "minimum confidence (0-1)")
+	exportCmd.Flags().StringVar(&exportFormat, "format", "json", "export format")
+
+	var checkFile string
+	checkCmd := &cobra.Command{
+		Use:   "check",
+		Short: "Validate an email list (syntax + MX)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if checkFile == "" {
+				return fmt.Errorf("--file is required")
+			}
+			checkEmails(checkFile, new(CheckerStats))
+			return nil
+		},
+	}
+	checkCmd.Flags().StringVar(&checkFile, "file", "", `email list to check ("-" for stdin)`)
+
+	rootCmd.AddCommand(searchCmd, stockCmd, exportCmd, checkCmd)
+}
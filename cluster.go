@@ -0,0 +1,227 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/spf13/cobra"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// Distributed runs split a search across machines through Redis: the
+// coordinator scrapes the providers (cheap) and pushes one validation
+// task per store; workers — typically on different IPs — pop tasks,
+// validate, and push the store back; the coordinator merges and persists
+// the results like a local run. Queue payloads are results in the usual
+// JSON layout.
+
+// clusterKeys are the Redis list names, namespaced by --queue so several
+// runs can share one Redis.
+type clusterKeys struct {
+	tasks   string
+	results string
+}
+
+func newClusterKeys(prefix string) clusterKeys {
+	return clusterKeys{tasks: prefix + ":tasks", results: prefix + ":results"}
+}
+
+// clusterClient connects and pings, so misconfiguration fails up front.
+func clusterClient(addr string) (*redis.Client, error) {
+	rdb := redis.NewClient(&redis.Options{
+		Addr:     addr,
+		Password: os.Getenv("CROWNPAL_REDIS_PASSWORD"),
+	})
+	if err := rdb.Ping(appCtx).Err(); err != nil {
+		rdb.Close()
+		return nil, fmt.Errorf("redis %s: %w", addr, err)
+	}
+	return rdb, nil
+}
+
+// coordinateRun scrapes, queues every store for validation and collects
+// the validated stores back until all are in or the context ends.
+func coordinateRun(rdb *redis.Client, keys clusterKeys, target int, formats string) error {
+	stats := search.NewStats()
+	results := search.ScrapeAll(appCtx, newSearchScraper(0), stats)
+	if len(results) > target {
+		results = results[:target]
+	}
+	if len(results) == 0 {
+		return fmt.Errorf("no stores scraped")
+	}
+
+	// A fresh run must not consume another run's leftovers.
+	rdb.Del(appCtx, keys.tasks, keys.results)
+	for _, r := range results {
+		task, err := json.Marshal(r)
+		if err != nil {
+			return err
+		}
+		if err := rdb.LPush(appCtx, keys.tasks, task).Err(); err != nil {
+			return err
+		}
+	}
+	fmt.Printf("📡 %d validation tasks queued on %s — waiting for workers\n", len(results), keys.tasks)
+
+	merged := make([]StoreResult, 0, len(results))
+	for len(merged) < len(results) {
+		if interrupted() {
+			break
+		}
+		entry, err := rdb.BRPop(appCtx, 5*time.Second, keys.results).Result()
+		if err == redis.Nil {
+			continue
+		}
+		if err != nil {
+			if interrupted() {
+				break
+			}
+			return err
+		}
+		var r StoreResult
+		if err := json.Unmarshal([]byte(entry[1]), &r); err != nil {
+			fmt.Printf("⚠️  Bad result payload: %v\n", err)
+			continue
+		}
+		if msg := r.Extra["validate_error"]; msg != "" {
+			stats.RecordErrorAt(r.URL, fmt.Errorf("%s", msg))
+		}
+		merged = append(merged, r)
+		if len(merged)%10 == 0 || len(merged) == len(results) {
+			fmt.Printf("\r📊 Validated: %d/%d   ", len(merged), len(results))
+		}
+	}
+	fmt.Println()
+
+	merged = SortByTrustScore(merged)
+	persistRunDB("cluster", stats, merged)
+	var exported []string
+	for _, format := range strings.Split(formats, ",") {
+		if format = strings.TrimSpace(format); format != "" {
+			if file := exportResults(merged, format); file != "" {
+				exported = append(exported, file)
+			}
+		}
+	}
+	recordRun(runRecord{
+		Mode:     "cluster",
+		Start:    stats.StartTime,
+		Duration: time.Since(stats.StartTime).Round(time.Second).String(),
+		Params:   map[string]string{"target": fmt.Sprint(target), "source": "coordinator"},
+		Counts:   map[string]int{"stores": len(merged), "errors": stats.Errors},
+		Exports:  exported,
+		Series:   stats.Series(),
+	})
+	fmt.Printf("🏁 Cluster run done: %d stores validated by workers\n", len(merged))
+	return nil
+}
+
+// workLoop pops validation tasks until the context ends. Validation
+// failures still return the store, annotated, so the coordinator can
+// finish and count the error.
+func workLoop(rdb *redis.Client, keys clusterKeys, workers int) error {
+	fmt.Printf("📡 Worker draining %s with %d validator(s); Ctrl+C to stop\n", keys.tasks, workers)
+	validator := newStoreValidator(0)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				entry, err := rdb.BRPop(appCtx, 5*time.Second, keys.tasks).Result()
+				if err == redis.Nil {
+					if interrupted() {
+						return
+					}
+					continue
+				}
+				if err != nil {
+					if interrupted() {
+						return
+					}
+					fmt.Printf("⚠️  Redis: %v\n", err)
+					time.Sleep(5 * time.Second)
+					continue
+				}
+				var r StoreResult
+				if err := json.Unmarshal([]byte(entry[1]), &r); err != nil {
+					fmt.Printf("⚠️  Bad task payload: %v\n", err)
+					continue
+				}
+				if err := validator.Validate(appCtx, &r); err != nil {
+					if r.Extra == nil {
+						r.Extra = make(map[string]string)
+					}
+					r.Extra["validate_error"] = err.Error()
+				}
+				payload, err := json.Marshal(r)
+				if err != nil {
+					continue
+				}
+				if err := rdb.LPush(appCtx, keys.results, payload).Err(); err != nil {
+					fmt.Printf("⚠️  Redis: %v\n", err)
+				}
+				logItem("✅ %s\n", r.URL)
+			}
+		}()
+	}
+	wg.Wait()
+	return nil
+}
+
+func init() {
+	var redisAddr, queuePrefix string
+	clusterCmd := &cobra.Command{
+		Use:   "cluster",
+		Short: "Split a large run across machines through a Redis queue",
+	}
+	clusterCmd.PersistentFlags().StringVar(&redisAddr, "redis", "localhost:6379",
+		"Redis address shared by coordinator and workers (password via CROWNPAL_REDIS_PASSWORD)")
+	clusterCmd.PersistentFlags().StringVar(&queuePrefix, "queue", "crownpal",
+		"queue name prefix, so runs can share one Redis")
+
+	var coordTarget int
+	var coordFormats string
+	coordinateCmd := &cobra.Command{
+		Use:   "coordinate",
+		Short: "Scrape providers and farm validation out to workers",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rdb, err := clusterClient(redisAddr)
+			if err != nil {
+				return err
+			}
+			defer rdb.Close()
+			return coordinateRun(rdb, newClusterKeys(queuePrefix), coordTarget, coordFormats)
+		},
+	}
+	coordinateCmd.Flags().IntVar(&coordTarget, "target", 100, "number of stores to find")
+	coordinateCmd.Flags().StringVar(&coordFormats, "format", "json", "comma-separated export formats")
+
+	var workWorkers int
+	workCmd := &cobra.Command{
+		Use:   "work",
+		Short: "Validate queued stores until interrupted",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rdb, err := clusterClient(redisAddr)
+			if err != nil {
+				return err
+			}
+			defer rdb.Close()
+			if workWorkers < 1 {
+				workWorkers = config.Threads
+			}
+			return workLoop(rdb, newClusterKeys(queuePrefix), workWorkers)
+		},
+	}
+	workCmd.Flags().IntVar(&workWorkers, "workers", 0, "concurrent validators (0 = the threads config)")
+
+	clusterCmd.AddCommand(coordinateCmd, workCmd)
+	rootCmd.AddCommand(clusterCmd)
+}
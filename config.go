@@ -0,0 +1,188 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Config holds everything loaded from config.json.
+type Config struct {
+	TelegramToken string `json:"telegram_token"`
+	// ChannelID is the legacy single-channel field, used when no
+	// channels/routes are configured.
+	ChannelID string `json:"channel_id"`
+	// Channels maps a channel name to its Telegram chat ID, e.g.
+	// {"default": "@crownpal", "alerts": "-1001234"}.
+	Channels map[string]string `json:"channels"`
+	// Routes maps an event kind (stock, search, export, general) to the
+	// channel name that should receive it; unrouted kinds go to "default".
+	Routes map[string]string `json:"routes"`
+	// TrustedUserIDs are the Telegram user IDs allowed to DM code lists to
+	// the stock bot.
+	TrustedUserIDs []int64 `json:"trusted_user_ids"`
+	// TelegramParseMode is sent with channel messages: "", "MarkdownV2"
+	// or "HTML". User-provided content is escaped to match.
+	TelegramParseMode string            `json:"telegram_parse_mode"`
+	Threads           int               `json:"threads"`
+	HTTP2             bool              `json:"http2"`
+	Proxies           []string          `json:"proxies"`
+	Headers           map[string]string `json:"headers"`
+	// HeaderProfiles overrides Headers per provider, e.g.
+	// {"allkeyshop": {"Referer": "https://www.allkeyshop.com/"}}.
+	HeaderProfiles map[string]map[string]string `json:"header_profiles"`
+	// ExportColumns limits which StoreResult fields CSV/XLSX exports emit;
+	// empty means all columns.
+	ExportColumns []string     `json:"export_columns"`
+	Upload        UploadConfig `json:"upload"`
+	// ExportFormats is the default selection offered after a search.
+	ExportFormats []string   `json:"export_formats"`
+	SMTP          SMTPConfig `json:"smtp"`
+	// StockImportAuth is sent as the Authorization header when importing
+	// stock from an https:// source.
+	StockImportAuth string `json:"stock_import_auth"`
+	// CodeRules maps a crown type to a regex every code of that type must
+	// match on add/import, e.g. {"game-key": "^[A-Z0-9]{5}(-[A-Z0-9]{5}){2}$"}.
+	CodeRules map[string]string `json:"code_rules"`
+	// EventWebhookURL receives JSON event notifications (run_started,
+	// run_finished, store_found, stock_low); empty disables them.
+	EventWebhookURL string `json:"event_webhook_url"`
+	// EventWebhookSecret signs event bodies (X-Signature, HMAC-SHA256 hex).
+	EventWebhookSecret string `json:"event_webhook_secret"`
+	// StockLowThreshold fires stock_low when unused stock drops below it;
+	// 0 disables the check.
+	StockLowThreshold int `json:"stock_low_threshold"`
+	// RestockToken is the shared bearer token required by the POST /restock
+	// listener; the listener refuses to start while it is empty.
+	RestockToken string `json:"restock_token"`
+	// RestockNotify posts a summary to the channel after each auto-restock
+	// cycle that changed anything.
+	RestockNotify bool `json:"restock_notify"`
+	// SearchNotify posts a summary to the channel after each finder run.
+	SearchNotify bool `json:"search_notify"`
+	// RejectConsumedImports refuses to re-add codes that were ever
+	// dispensed or removed (tracked in consumed_codes.json).
+	RejectConsumedImports bool `json:"reject_consumed_imports"`
+	// ExportTemplate is the default template file for the "template"
+	// export format; headless runs require it.
+	ExportTemplate string `json:"export_template"`
+	// AutoChannelUpload sends export files to the channel without asking,
+	// which headless runs rely on.
+	AutoChannelUpload bool `json:"auto_channel_upload"`
+	// OTLPEndpoint is an OpenTelemetry collector (host:port) receiving run
+	// metrics and traces over OTLP/HTTP; empty disables telemetry.
+	OTLPEndpoint string `json:"otlp_endpoint"`
+	// Language picks the UI language ("en", "ru"); empty falls back to the
+	// LANG environment variable, then English.
+	Language string `json:"language"`
+	// Schedules defines recurring jobs (nightly search, hourly
+	// revalidation, weekly prune, daily stock backup) the scheduler runs
+	// while the app is up; see ScheduleConfig.
+	Schedules []ScheduleConfig `json:"schedules"`
+	// OutputDir is where all exports land; empty means the current directory.
+	OutputDir string `json:"output_dir"`
+	// FilenameTemplate names output files; see outputPath for placeholders.
+	FilenameTemplate string `json:"filename_template"`
+}
+
+var config Config
+
+const configFile = "config.json"
+
+// activeProfile selects a named configuration profile; each profile lives
+// in its own config.<name>.json with its own proxies, rate limits and
+// notification targets.
+var activeProfile string
+
+// configPath is the config file for the active profile, in the platform
+// config directory unless one already exists in the working directory.
+func configPath() string {
+	if activeProfile == "" {
+		return configFilePath(configFile)
+	}
+	return configFilePath(fmt.Sprintf("config.%s.json", activeProfile))
+}
+
+func loadConfig() {
+	// Deferred overlays run in reverse order: workspace overrides first,
+	// environment overrides win last.
+	defer applyEnvOverrides()
+	defer applyWorkspaceOverrides()
+	data, err := os.ReadFile(configPath())
+	if err != nil {
+		// First run: fall back to defaults, a config file is optional.
+		config = defaultConfig()
+		return
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		fmt.Printf("⚠️  Invalid %s: %v (using defaults)\n", configPath(), err)
+		config = defaultConfig()
+		return
+	}
+	if config.Threads <= 0 {
+		config.Threads = 10
+	}
+}
+
+// switchProfile reloads the configuration from another profile's file.
+func switchProfile(name string) {
+	activeProfile = name
+	loadConfig()
+	fmt.Printf("✅ Using %s\n", configPath())
+}
+
+// applyEnvOverrides lets CROWNPAL_* environment variables override the
+// config file, so scheduled headless jobs in containers can inject
+// credentials and tuning without editing config.json.
+func applyEnvOverrides() {
+	if v := os.Getenv("CROWNPAL_TELEGRAM_TOKEN"); v != "" {
+		config.TelegramToken = v
+	}
+	if v := os.Getenv("CROWNPAL_CHANNEL_ID"); v != "" {
+		config.ChannelID = v
+	}
+	if v := os.Getenv("CROWNPAL_THREADS"); v != "" {
+		if n, err := strconv.Atoi(v); err == nil && n > 0 {
+			config.Threads = n
+		} else {
+			fmt.Printf("⚠️  Ignoring invalid CROWNPAL_THREADS %q\n", v)
+		}
+	}
+	if v := os.Getenv("CROWNPAL_PROXIES"); v != "" {
+		config.Proxies = nil
+		for _, p := range strings.Split(v, ",") {
+			if p = strings.TrimSpace(p); p != "" {
+				config.Proxies = append(config.Proxies, p)
+			}
+		}
+	}
+	if v := os.Getenv("CROWNPAL_OUTPUT_DIR"); v != "" {
+		config.OutputDir = v
+	}
+	if v := os.Getenv("CROWNPAL_RESTOCK_TOKEN"); v != "" {
+		config.RestockToken = v
+	}
+	if v := os.Getenv("CROWNPAL_EVENT_WEBHOOK_SECRET"); v != "" {
+		config.EventWebhookSecret = v
+	}
+}
+
+func defaultConfig() Config {
+	return Config{
+		Threads: 10,
+		Headers: map[string]string{
+			"Accept":          "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8",
+			"Accept-Language": "en-US,en;q=0.9",
+		},
+	}
+}
+
+func saveConfig() error {
+	data, err := json.MarshalIndent(config, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(configPath(), data, 0644)
+}
@@ -0,0 +1,7 @@
+//go:build !windows
+
+package main
+
+// enableVirtualTerminal is a no-op outside Windows; Unix terminals handle
+// ANSI escape sequences natively.
+func enableVirtualTerminal() {}
@@ -0,0 +1,812 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	crownstock "github.com/sushuhq-glitch/exe-decompiler-pro/internal/stock"
+)
+
+// Crown is a single stocked code; the type lives in internal/stock so the
+// store implementations can share it. The import is aliased because this
+// package already uses "stock" for the in-memory slice.
+type Crown = crownstock.Crown
+
+// stock holds the in-memory crown records; expiredStock receives whatever
+// the sweep moves out. stockMu guards both for callers that run off the
+// main menu goroutine (Telegram bot, HTTP API, auto restock).
+var (
+	stockMu      sync.Mutex
+	stock        []Crown
+	expiredStock []Crown
+)
+
+// errPoolEmpty is returned when a pool has no unused crowns left.
+var errPoolEmpty = crownstock.ErrPoolEmpty
+
+// DispenseNext atomically marks and returns the oldest unused crown in the
+// given pool ("" means the default pool).
+func DispenseNext(poolName string) (Crown, error) {
+	return store.Dispense(poolName)
+}
+
+// dispenseLocked is the store's dispense implementation.
+func dispenseLocked(poolName string) (Crown, error) {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+
+	oldest := -1
+	for i, c := range stock {
+		if c.Used || c.Expired() || c.Pool != poolName {
+			continue
+		}
+		if oldest == -1 || c.AddedAt.Before(stock[oldest].AddedAt) {
+			oldest = i
+		}
+	}
+	if oldest == -1 {
+		return Crown{}, errPoolEmpty
+	}
+	now := time.Now()
+	stock[oldest].Used = true
+	stock[oldest].UsedAt = &now
+	recordConsumed(stock[oldest].Code)
+	return stock[oldest], nil
+}
+
+// sweepExpiredStock moves expired crowns into the expired list and returns
+// how many were moved. It runs on the sweeper goroutine, so it takes the
+// lock like any other off-menu stock access.
+func sweepExpiredStock() int {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	var kept []Crown
+	moved := 0
+	for _, c := range stock {
+		if c.Expired() {
+			expiredStock = append(expiredStock, c)
+			moved++
+			continue
+		}
+		kept = append(kept, c)
+	}
+	stock = kept
+	return moved
+}
+
+// startExpirySweeper runs the sweep in the background once a minute.
+func startExpirySweeper() {
+	go func() {
+		for {
+			time.Sleep(time.Minute)
+			if n := sweepExpiredStock(); n > 0 {
+				fmt.Printf("\n⌛ %d expired crowns moved out of stock\n", n)
+			}
+		}
+	}()
+}
+
+func countNearExpiry() int {
+	n := 0
+	for _, c := range stock {
+		if c.NearExpiry() {
+			n++
+		}
+	}
+	return n
+}
+
+const stockFile = "stock.json"
+
+// loadStockSnapshot restores the stock saveStockSnapshot wrote, so a
+// restart carries on with the same crowns. A missing file is an empty
+// stock. Startup initialization, so it assigns under the lock instead of
+// going through the store: no stock_changed event should fire yet.
+func loadStockSnapshot() {
+	path := statePath(stockFile)
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return
+	}
+	var crowns []Crown
+	if err := json.Unmarshal(data, &crowns); err != nil {
+		fmt.Printf("⚠️  Invalid %s: %v (starting with empty stock)\n", path, err)
+		return
+	}
+	stockMu.Lock()
+	stock = crowns
+	stockMu.Unlock()
+	if len(crowns) > 0 {
+		fmt.Printf("📦 Restored %d crowns from %s\n", len(crowns), path)
+	}
+}
+
+// saveStockSnapshot dumps the in-memory stock to stockFile so a shutdown
+// doesn't lose it.
+func saveStockSnapshot() {
+	crowns := store.List()
+	if len(crowns) == 0 {
+		return
+	}
+	data, err := json.MarshalIndent(crowns, "", "  ")
+	if err != nil {
+		return
+	}
+	path := statePath(stockFile)
+	if err := writeFileAtomic(path, data); err != nil {
+		fmt.Printf("⚠️  Cannot save %s: %v\n", path, err)
+		return
+	}
+	fmt.Printf("💾 Saved %d crowns to %s\n", len(crowns), path)
+}
+
+// TypeSummary is the per-type count and total value of stocked crowns.
+type TypeSummary struct {
+	Count int     `json:"count"`
+	Value float64 `json:"value"`
+}
+
+// stockValueSummary groups unused counts and total value by crown type.
+func stockValueSummary(crowns []Crown) map[string]TypeSummary {
+	summary := make(map[string]TypeSummary)
+	for _, c := range crowns {
+		s := summary[c.Type]
+		s.Count++
+		s.Value += c.Value
+		summary[c.Type] = s
+	}
+	return summary
+}
+
+// printStockSummary renders the per-type summary table.
+func printStockSummary(crowns []Crown) {
+	summary := stockValueSummary(crowns)
+	if len(summary) == 0 {
+		return
+	}
+	types := make([]string, 0, len(summary))
+	for t := range summary {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	fmt.Println("\n── Stock value by type ──")
+	fmt.Printf("%-16s %8s %12s\n", "type", "count", "value")
+	total := TypeSummary{}
+	for _, t := range types {
+		s := summary[t]
+		fmt.Printf("%-16s %8d %12.2f\n", t, s.Count, s.Value)
+		total.Count += s.Count
+		total.Value += s.Value
+	}
+	fmt.Printf("%-16s %8d %12.2f\n", "total", total.Count, total.Value)
+}
+
+// crownStockManagement loops over the stock menu until the user backs
+// out; it used to recurse into itself, which eventually blew the stack.
+func crownStockManagement() {
+	for {
+		clearScreen()
+		fmt.Println("═══════════ CROWN STOCK ═══════════")
+		fmt.Printf("Current stock: %d crowns (%d unused)\n", len(stock), countUnused())
+		if n := countNearExpiry(); n > 0 {
+			fmt.Printf("⚠️  %d crowns expire within 7 days\n", n)
+		}
+		if len(expiredStock) > 0 {
+			fmt.Printf("⌛ %d expired crowns set aside\n", len(expiredStock))
+		}
+		if line := restock.statusLine(); line != "" {
+			fmt.Println(line)
+		}
+		fmt.Println()
+		fmt.Println("[1] Add crowns")
+		fmt.Println("[2] View stock")
+		fmt.Println("[3] Remove a crown")
+		fmt.Println("[4] Import from file")
+		fmt.Println("[5] Export to file")
+		fmt.Println("[6] Clear all stock")
+		fmt.Println("[7] Auto Restock")
+		fmt.Println("[8] Dispense a crown")
+		fmt.Println("[9] Usage history")
+		fmt.Println("[10] Undo last operation")
+		fmt.Println("[11] Dispense next (oldest unused)")
+		fmt.Println("[12] Export as Telegram/Discord message")
+		fmt.Println("[13] Stop auto restock")
+		fmt.Println("[14] Start restock listener (HTTP)")
+		fmt.Println("[15] Start Telegram stock bot")
+		fmt.Println("[0] Back")
+
+		choice := promptChoice("Choice: ", 0, 15)
+		if choice == 0 {
+			return
+		}
+		handleStockChoice(choice)
+		pause()
+	}
+}
+
+// handleStockChoice dispatches one stock menu selection.
+func handleStockChoice(choice int) {
+	switch choice {
+	case 1:
+		journalStock("add crowns")
+		addCrowns()
+	case 2:
+		viewStock()
+	case 3:
+		journalStock("remove crown")
+		removeCrown()
+	case 4:
+		path := promptLine("File path or https:// URL: ")
+		recordSession("stock_import", map[string]string{"source": path})
+		journalStock("import " + path)
+		importStock(path)
+	case 5:
+		path := promptLine("Destination path (empty for default): ")
+		if path == "" {
+			path = outputPath("stock", "json")
+		}
+		exportStock(path)
+	case 6:
+		journalStock("clear all stock")
+		store.Replace(nil)
+		fmt.Println("🗑️  Stock cleared")
+	case 7:
+		path := promptLine("File, URL or directory to watch: ")
+		dryRun := promptYes("Dry run (report only, no changes)? [y/N]: ")
+		if err := startAutoRestock(path, 60*time.Second, dryRun); err != nil {
+			fmt.Printf("❌ %v\n", err)
+		} else {
+			fmt.Printf("🔄 Auto restock from %s every 60s started in the background\n", path)
+		}
+	case 8:
+		journalStock("dispense crown")
+		dispenseCrown()
+	case 9:
+		viewUsageHistory()
+	case 10:
+		undoLastStockOp()
+	case 11:
+		pool := promptLine("Pool (empty for default): ")
+		journalStock("dispense next")
+		c, err := DispenseNext(pool)
+		if err != nil {
+			fmt.Printf("❌ %v\n", err)
+		} else {
+			fmt.Printf("🎁 Dispensed %s (%s)\n", c.Code, c.Type)
+		}
+	case 12:
+		exportStockMessage()
+	case 13:
+		if stopAutoRestock() {
+			fmt.Println("🏁 Auto restock stopped")
+		} else {
+			fmt.Println("❌ Auto restock is not running")
+		}
+	case 14:
+		addr := promptLine("Listen address (default :8680): ")
+		if addr == "" {
+			addr = ":8680"
+		}
+		if err := startRestockListener(addr); err != nil {
+			fmt.Printf("❌ %v\n", err)
+		} else {
+			fmt.Printf("🔄 Restock listener on %s (POST /restock)\n", addr)
+		}
+	case 15:
+		if err := startStockBot(); err != nil {
+			fmt.Printf("❌ %v\n", err)
+		} else {
+			fmt.Println("📧 Stock bot listening for DMs from trusted users")
+		}
+	}
+}
+
+func countUnused() int {
+	n := 0
+	for _, c := range stock {
+		if !c.Used {
+			n++
+		}
+	}
+	return n
+}
+
+// promptCrownMeta asks for the type and value applied to a batch of codes.
+func promptCrownMeta() (string, float64) {
+	crownType := promptLine("Crown type (e.g. gift-card, game-key): ")
+	if crownType == "" {
+		crownType = "generic"
+	}
+	value, _ := strconv.ParseFloat(promptLine("Value per crown (0 for none): "), 64)
+	return crownType, value
+}
+
+// promptTagsNote asks for optional free-form tags and a note applied to a
+// batch of codes (e.g. supplier or batch number).
+func promptTagsNote() ([]string, string) {
+	tags := parseTags(promptLine("Tags, comma-separated (empty for none): "))
+	note := promptLine("Note (empty for none): ")
+	return tags, note
+}
+
+// parseTags splits a comma-separated tag list, dropping empties.
+func parseTags(raw string) []string {
+	var tags []string
+	for _, t := range strings.Split(raw, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
+
+// promptExpiry asks for an optional expiry date (YYYY-MM-DD).
+func promptExpiry() *time.Time {
+	raw := promptLine("Expiry date YYYY-MM-DD (empty for none): ")
+	if raw == "" {
+		return nil
+	}
+	t, err := time.Parse("2006-01-02", raw)
+	if err != nil {
+		fmt.Printf("⚠️  Ignoring invalid date %q\n", raw)
+		return nil
+	}
+	return &t
+}
+
+func addCrowns() {
+	crownType, value := promptCrownMeta()
+	expiresAt := promptExpiry()
+	tags, note := promptTagsNote()
+	fmt.Println("Enter codes one per line (empty line to finish):")
+	added := 0
+	rejected := 0
+	var batch []Crown
+	for _, line := range promptLines() {
+		code := strings.TrimSpace(line)
+		if err := validateCode(crownType, code); err != nil {
+			fmt.Printf("❌ %s: %v\n", code, err)
+			rejected++
+			continue
+		}
+		batch = append(batch, Crown{
+			Code:      code,
+			Type:      crownType,
+			Value:     value,
+			AddedAt:   time.Now(),
+			ExpiresAt: expiresAt,
+			Tags:      tags,
+			Note:      note,
+		})
+	}
+	added, _ = store.Add(batch...)
+	fmt.Printf("✅ Added %d crowns (total %d", added, store.Count())
+	if rejected > 0 {
+		fmt.Printf(", %d rejected", rejected)
+	}
+	fmt.Println(")")
+}
+
+// viewStock lists the stock with optional search, type/status filters and
+// sorting so large stocks stay navigable.
+func viewStock() {
+	if len(stock) == 0 {
+		fmt.Println("📦 Stock is empty")
+		return
+	}
+	query := promptLine("Search code (prefix or substring, empty for all): ")
+	typeFilter := promptLine("Type filter (empty for all): ")
+	tagFilter := promptLine("Tag filter (empty for all): ")
+	status := promptLine("Status [all/used/unused] (default all): ")
+	sortBy := promptLine("Sort by [added/code/type/value] (default added): ")
+
+	filtered := filterStock(stock, query, typeFilter, tagFilter, status)
+	sortStock(filtered, sortBy)
+	if len(filtered) == 0 {
+		fmt.Println("📦 No crowns match")
+		return
+	}
+	fmt.Printf("📦 %d of %d crowns:\n", len(filtered), len(stock))
+	pageStock(filtered)
+}
+
+// filterStock applies search, type, tag and used-status filters. A query
+// starting with '^' matches as a prefix, otherwise as a substring.
+func filterStock(crowns []Crown, query, typeFilter, tagFilter, status string) []Crown {
+	var out []Crown
+	prefix := strings.HasPrefix(query, "^")
+	query = strings.ToLower(strings.TrimPrefix(query, "^"))
+	for _, c := range crowns {
+		code := strings.ToLower(c.Code)
+		if query != "" {
+			if prefix && !strings.HasPrefix(code, query) {
+				continue
+			}
+			if !prefix && !strings.Contains(code, query) {
+				continue
+			}
+		}
+		if typeFilter != "" && !strings.EqualFold(c.Type, typeFilter) {
+			continue
+		}
+		if tagFilter != "" && !c.HasTag(tagFilter) {
+			continue
+		}
+		switch status {
+		case "used":
+			if !c.Used {
+				continue
+			}
+		case "unused":
+			if c.Used {
+				continue
+			}
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func sortStock(crowns []Crown, by string) {
+	switch by {
+	case "code":
+		sort.Slice(crowns, func(i, j int) bool { return crowns[i].Code < crowns[j].Code })
+	case "type":
+		sort.Slice(crowns, func(i, j int) bool { return crowns[i].Type < crowns[j].Type })
+	case "value":
+		sort.Slice(crowns, func(i, j int) bool { return crowns[i].Value > crowns[j].Value })
+	default:
+		sort.Slice(crowns, func(i, j int) bool { return crowns[i].AddedAt.Before(crowns[j].AddedAt) })
+	}
+}
+
+// stockPageSize is how many crowns one page shows.
+const stockPageSize = 25
+
+// pageStock shows crowns one page at a time with next/prev/jump commands.
+// Row numbers stay absolute so they can be used in the removal picker.
+func pageStock(crowns []Crown) {
+	if len(crowns) <= stockPageSize {
+		listStock(crowns, 0)
+		return
+	}
+	pages := (len(crowns) + stockPageSize - 1) / stockPageSize
+	page := 0
+	for {
+		start := page * stockPageSize
+		end := start + stockPageSize
+		if end > len(crowns) {
+			end = len(crowns)
+		}
+		listStock(crowns[start:end], start)
+		fields := strings.Fields(promptLine(
+			fmt.Sprintf("─ page %d/%d ─ [n]ext [p]rev [j]ump <n> [q]uit: ", page+1, pages)))
+		cmd, arg := "", ""
+		if len(fields) > 0 {
+			cmd = fields[0]
+		}
+		if len(fields) > 1 {
+			arg = fields[1]
+		}
+		switch cmd {
+		case "n", "":
+			if page < pages-1 {
+				page++
+			}
+		case "p":
+			if page > 0 {
+				page--
+			}
+		case "j":
+			n, err := strconv.Atoi(arg)
+			if err != nil || n < 1 || n > pages {
+				fmt.Println("❌ Invalid page")
+				continue
+			}
+			page = n - 1
+		case "q":
+			return
+		}
+	}
+}
+
+// listStock renders crowns in the stock table layout; offset shifts the
+// printed row numbers for paged output.
+func listStock(crowns []Crown, offset int) {
+	fmt.Printf("%4s  %-28s %-14s %8s  %-16s %-10s %-4s %s\n", "#", "code", "type", "value", "added", "expires", "used", "tags")
+	for i, c := range crowns {
+		used := ""
+		if c.Used {
+			used = "✔"
+		}
+		expires := "-"
+		if c.ExpiresAt != nil {
+			expires = c.ExpiresAt.Format("2006-01-02")
+			if c.NearExpiry() {
+				expires += " ⚠️"
+			}
+		}
+		extra := strings.Join(c.Tags, ",")
+		if c.Note != "" {
+			if extra != "" {
+				extra += " "
+			}
+			extra += "(" + c.Note + ")"
+		}
+		fmt.Printf("%4d. %-28s %-14s %8.2f  %-16s %-10s %-4s %s\n",
+			offset+i+1, c.Code, c.Type, c.Value, c.AddedAt.Format("2006-01-02 15:04"), expires, used, extra)
+	}
+}
+
+func removeCrown() {
+	if len(stock) == 0 {
+		fmt.Println("📦 Stock is empty")
+		return
+	}
+	pageStock(stock)
+	n := promptChoice("Number to remove: ", 1, len(stock))
+	removed := stock[n-1]
+	if store.Remove(removed.Code) {
+		fmt.Printf("🗑️  Removed %s\n", removed.Code)
+	} else {
+		fmt.Printf("❌ %s was already gone\n", removed.Code)
+	}
+}
+
+// dispenseCrown marks a crown used (with timestamp and optional note)
+// instead of deleting it, so the history shows what was handed out.
+func dispenseCrown() {
+	crowns := store.List()
+	if len(crowns) == 0 {
+		fmt.Println("📦 Stock is empty")
+		return
+	}
+	pageStock(crowns)
+	n := promptChoice("Number to dispense: ", 1, len(crowns))
+	code := crowns[n-1].Code
+	if crowns[n-1].Used {
+		fmt.Printf("❌ %s was already dispensed\n", code)
+		return
+	}
+	note := promptLine("Note (optional): ")
+	if _, err := store.MarkUsed(code, note); err != nil {
+		fmt.Printf("❌ %s: %v\n", code, err)
+		return
+	}
+	fmt.Printf("🎁 Dispensed %s\n", code)
+}
+
+// viewUsageHistory lists dispensed crowns, most recent first.
+func viewUsageHistory() {
+	var used []Crown
+	for _, c := range stock {
+		if c.Used {
+			used = append(used, c)
+		}
+	}
+	if len(used) == 0 {
+		fmt.Println("📦 Nothing dispensed yet")
+		return
+	}
+	sort.Slice(used, func(i, j int) bool {
+		ti, tj := time.Time{}, time.Time{}
+		if used[i].UsedAt != nil {
+			ti = *used[i].UsedAt
+		}
+		if used[j].UsedAt != nil {
+			tj = *used[j].UsedAt
+		}
+		return ti.After(tj)
+	})
+	fmt.Printf("🎁 Usage history (%d):\n", len(used))
+	for _, c := range used {
+		when := "unknown"
+		if c.UsedAt != nil {
+			when = c.UsedAt.Format("2006-01-02 15:04")
+		}
+		line := fmt.Sprintf("%s  %-28s %-14s", when, c.Code, c.Type)
+		if c.UsedNote != "" {
+			line += "  (" + c.UsedNote + ")"
+		}
+		fmt.Println(line)
+	}
+}
+
+// readStockSource loads raw stock bytes from a local path or, when the
+// source starts with http:// or https://, from a remote URL (sending the
+// configured auth header if any).
+func readStockSource(source string) ([]byte, error) {
+	if !strings.HasPrefix(source, "http://") && !strings.HasPrefix(source, "https://") {
+		return readListInput(source)
+	}
+	if dryRunSkip("GET " + source + " (stock import)") {
+		return nil, nil
+	}
+	req, err := http.NewRequestWithContext(appCtx, "GET", source, nil)
+	if err != nil {
+		return nil, err
+	}
+	if config.StockImportAuth != "" {
+		req.Header.Set("Authorization", config.StockImportAuth)
+	}
+	resp, err := newHTTPClient(time.Minute).Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d from %s", resp.StatusCode, source)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 32*1024*1024))
+}
+
+// parseStockCrowns decodes raw stock bytes: .json sources are Crown
+// exports, anything else is a plain list with one code per line. Invalid
+// records are reported line by line and counted.
+func parseStockCrowns(source string, data []byte) (batch []Crown, invalid int, err error) {
+	if strings.EqualFold(filepath.Ext(strings.SplitN(source, "?", 2)[0]), ".json") {
+		crowns, err := decodeStockJSON(data)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i, c := range crowns {
+			if err := validateCode(c.Type, c.Code); err != nil {
+				fmt.Printf("❌ Record %d (%s): %v\n", i+1, c.Code, err)
+				invalid++
+				continue
+			}
+			if c.AddedAt.IsZero() {
+				c.AddedAt = time.Now()
+			}
+			batch = append(batch, c)
+		}
+		return batch, invalid, nil
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		code := strings.TrimSpace(scanner.Text())
+		if code == "" {
+			continue
+		}
+		if err := validateCode("generic", code); err != nil {
+			fmt.Printf("❌ Line %d (%s): %v\n", lineNo, code, err)
+			invalid++
+			continue
+		}
+		batch = append(batch, Crown{Code: code, Type: "generic", AddedAt: time.Now()})
+	}
+	return batch, invalid, nil
+}
+
+// importChunkSize is how many streamed codes reach the store per Add
+// call when importing a plain list.
+const importChunkSize = 500
+
+// importStock merges crowns from a file or URL; it returns the per-cycle
+// counts for restock notifications. JSON exports and remote URLs are read
+// whole (JSON needs the full document and URL bodies are size-capped);
+// plain local lists stream line by line so huge files never load at once.
+func importStock(source string) (added, skipped, invalid int) {
+	remote := strings.HasPrefix(source, "http://") || strings.HasPrefix(source, "https://")
+	isJSON := strings.EqualFold(filepath.Ext(strings.SplitN(source, "?", 2)[0]), ".json")
+	if !remote && !isJSON {
+		return importStockStream(source)
+	}
+	data, err := readStockSource(source)
+	if err != nil {
+		fmt.Printf("❌ Cannot read %s: %v\n", source, err)
+		return
+	}
+	batch, invalid, err := parseStockCrowns(source, data)
+	if err != nil {
+		fmt.Printf("❌ Invalid stock file %s: %v\n", source, err)
+		return 0, 0, invalid
+	}
+	added, skipped = store.Add(batch...)
+	fmt.Printf("✅ Imported %d crowns (%d duplicates skipped, %d invalid, total %d)\n",
+		added, skipped, invalid, store.Count())
+	return added, skipped, invalid
+}
+
+// importStockStream merges a plain code list without holding it in
+// memory: lines arrive through a bounded channel and reach the store in
+// chunks, with byte-based progress for very large files.
+func importStockStream(source string) (added, skipped, invalid int) {
+	lines, progress, readErr, err := streamLines(source)
+	if err != nil {
+		fmt.Printf("❌ Cannot read %s: %v\n", source, err)
+		return
+	}
+	batch := make([]Crown, 0, importChunkSize)
+	flush := func() {
+		a, s := store.Add(batch...)
+		added += a
+		skipped += s
+		batch = batch[:0]
+	}
+	lineNo := 0
+	for code := range lines {
+		lineNo++
+		if lineNo%streamProgressEvery == 0 {
+			fmt.Printf("⌛ %d lines — %s read\n", lineNo, progress)
+		}
+		if err := validateCode("generic", code); err != nil {
+			fmt.Printf("❌ Entry %d (%s): %v\n", lineNo, code, err)
+			invalid++
+			continue
+		}
+		batch = append(batch, Crown{Code: code, Type: "generic", AddedAt: time.Now()})
+		if len(batch) == importChunkSize {
+			flush()
+		}
+	}
+	flush()
+	if err := readErr(); err != nil {
+		fmt.Printf("⚠️  Read error after %d entries: %v\n", lineNo, err)
+	}
+	fmt.Printf("✅ Imported %d crowns (%d duplicates skipped, %d invalid, total %d)\n",
+		added, skipped, invalid, store.Count())
+	return added, skipped, invalid
+}
+
+// decodeStockJSON accepts both the wrapper layout written by exportStock
+// and a bare Crown array from older exports.
+func decodeStockJSON(data []byte) ([]Crown, error) {
+	var wrapper struct {
+		Crowns []Crown `json:"crowns"`
+	}
+	if err := json.Unmarshal(data, &wrapper); err == nil && wrapper.Crowns != nil {
+		return wrapper.Crowns, nil
+	}
+	var crowns []Crown
+	if err := json.Unmarshal(data, &crowns); err != nil {
+		return nil, err
+	}
+	return crowns, nil
+}
+
+// exportStock writes the stock: .json keeps every Crown field, any other
+// extension writes plain code lines.
+func exportStock(path string) {
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		export := struct {
+			Summary map[string]TypeSummary `json:"summary"`
+			Crowns  []Crown                `json:"crowns"`
+		}{stockValueSummary(stock), stock}
+		data, err := json.MarshalIndent(export, "", "  ")
+		if err != nil {
+			fmt.Printf("❌ Export failed: %v\n", err)
+			return
+		}
+		if err := writeFileAtomic(path, data); err != nil {
+			fmt.Printf("❌ Cannot create %s: %v\n", path, err)
+			return
+		}
+	} else {
+		err := withAtomicFile(path, func(f *os.File) error {
+			for _, c := range stock {
+				if _, err := fmt.Fprintln(f, c.Code); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
+			fmt.Printf("❌ Cannot create %s: %v\n", path, err)
+			return
+		}
+	}
+	fmt.Printf("💾 Exported %d crowns to %s\n", len(stock), path)
+	offerChannelUpload([]string{path})
+}
@@ -0,0 +1,479 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/storedb"
+)
+
+// daemonJobsFile keeps job status across daemon restarts, so history stays
+// queryable; jobs still queued at shutdown are recorded as failed.
+const daemonJobsFile = "jobs.json"
+
+// daemonJobsMax caps the queue depth and how much history the file keeps.
+const daemonJobsMax = 200
+
+// daemonJob is one queued unit of work and its lifecycle.
+type daemonJob struct {
+	ID       int               `json:"id"`
+	Kind     string            `json:"kind"`
+	Params   map[string]string `json:"params,omitempty"`
+	Status   string            `json:"status"` // queued, running, done, failed
+	Enqueued time.Time         `json:"enqueued"`
+	Started  time.Time         `json:"started,omitempty"`
+	Finished time.Time         `json:"finished,omitempty"`
+	Summary  string            `json:"summary,omitempty"`
+	Error    string            `json:"error,omitempty"`
+}
+
+// describe renders a job for status lists and Telegram replies.
+func (j daemonJob) describe() string {
+	s := fmt.Sprintf("#%d %-10s %s", j.ID, j.Kind, j.Status)
+	if j.Summary != "" {
+		s += " — " + j.Summary
+	}
+	if j.Error != "" {
+		s += " — " + j.Error
+	}
+	return s
+}
+
+// jobKinds maps each accepted kind to its parameter help, for rejections
+// and the Telegram /job usage reply.
+var jobKinds = map[string]string{
+	"search":     "run the finder (params: target, format)",
+	"revalidate": "re-validate a saved results file (params: input)",
+	"export":     "re-export a saved results file (params: input, format)",
+	"restock":    "import codes from a file, URL or directory (params: source)",
+	"prune":      "drop stores not seen recently from the database (params: days)",
+	"backup":     "write a timestamped stock backup (no params)",
+}
+
+var (
+	jobQueueMu sync.Mutex
+	jobQueue   []*daemonJob
+	jobCh      chan *daemonJob
+	nextJobID  int
+	daemonOn   bool
+)
+
+// enqueueJob validates and queues a job; it fails when the process is not
+// in daemon mode or the queue is full.
+func enqueueJob(kind string, params map[string]string) (*daemonJob, error) {
+	if _, ok := jobKinds[kind]; !ok {
+		return nil, fmt.Errorf("unknown job kind %q (search, revalidate, export, restock)", kind)
+	}
+	jobQueueMu.Lock()
+	defer jobQueueMu.Unlock()
+	if !daemonOn {
+		return nil, fmt.Errorf("daemon mode is not running")
+	}
+	nextJobID++
+	job := &daemonJob{
+		ID:       nextJobID,
+		Kind:     kind,
+		Params:   params,
+		Status:   "queued",
+		Enqueued: time.Now(),
+	}
+	select {
+	case jobCh <- job:
+	default:
+		nextJobID--
+		return nil, fmt.Errorf("job queue is full (%d jobs)", daemonJobsMax)
+	}
+	jobQueue = append(jobQueue, job)
+	if len(jobQueue) > daemonJobsMax {
+		jobQueue = jobQueue[len(jobQueue)-daemonJobsMax:]
+	}
+	persistJobsLocked()
+	return job, nil
+}
+
+// jobSnapshot copies the queue for handlers to render without holding the
+// lock.
+func jobSnapshot() []daemonJob {
+	jobQueueMu.Lock()
+	defer jobQueueMu.Unlock()
+	jobs := make([]daemonJob, len(jobQueue))
+	for i, j := range jobQueue {
+		jobs[i] = *j
+	}
+	return jobs
+}
+
+// persistJobsLocked writes the queue to disk; callers hold jobQueueMu.
+func persistJobsLocked() {
+	data, err := json.MarshalIndent(jobQueue, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(daemonJobsFile), data); err != nil {
+		fmt.Printf("⚠️  Could not save job history: %v\n", err)
+	}
+}
+
+// loadJobHistory restores past jobs so history survives restarts. Jobs
+// that never finished are marked failed — the work itself is not replayed.
+func loadJobHistory() {
+	data, err := os.ReadFile(statePath(daemonJobsFile))
+	if err != nil {
+		return
+	}
+	var jobs []*daemonJob
+	if err := json.Unmarshal(data, &jobs); err != nil {
+		return
+	}
+	for _, j := range jobs {
+		if j.Status == "queued" || j.Status == "running" {
+			j.Status = "failed"
+			j.Error = "daemon stopped before the job finished"
+		}
+		if j.ID > nextJobID {
+			nextJobID = j.ID
+		}
+	}
+	jobQueue = jobs
+}
+
+// daemonWorker drains the queue; several run concurrently when the daemon
+// is started with --workers above one.
+func daemonWorker() {
+	for job := range jobCh {
+		if interrupted() {
+			return
+		}
+		jobQueueMu.Lock()
+		job.Status = "running"
+		job.Started = time.Now()
+		persistJobsLocked()
+		jobQueueMu.Unlock()
+		fmt.Printf("\n🔄 Job #%d (%s) started\n", job.ID, job.Kind)
+
+		summary, err := executeJob(job)
+
+		jobQueueMu.Lock()
+		job.Finished = time.Now()
+		if err != nil {
+			job.Status = "failed"
+			job.Error = err.Error()
+			fmt.Printf("❌ Job #%d failed: %v\n", job.ID, err)
+		} else {
+			job.Status = "done"
+			job.Summary = summary
+			fmt.Printf("✅ Job #%d done: %s\n", job.ID, summary)
+		}
+		persistJobsLocked()
+		jobQueueMu.Unlock()
+	}
+}
+
+// executeJob dispatches on the job kind and returns a one-line summary.
+// It is shared between the daemon queue and the scheduler.
+func executeJob(job *daemonJob) (string, error) {
+	switch job.Kind {
+	case "search":
+		return jobSearch(job.Params)
+	case "revalidate":
+		return jobRevalidate(job.Params)
+	case "export":
+		return jobExport(job.Params)
+	case "restock":
+		return jobRestock(job.Params)
+	case "prune":
+		return jobPrune(job.Params)
+	case "backup":
+		return jobBackup(job.Params)
+	}
+	return "", fmt.Errorf("unknown job kind %q", job.Kind)
+}
+
+// jobSearch runs the finder like the search subcommand does.
+func jobSearch(params map[string]string) (string, error) {
+	target, _ := strconv.Atoi(params["target"])
+	if target <= 0 {
+		target = 100
+	}
+	cfg := SearchConfig{
+		MaxWorkers:      config.Threads,
+		Timeout:         15 * time.Second,
+		RateLimitPerSec: 5,
+		TargetStores:    target,
+	}
+	stats := search.NewStats()
+	results := runSearch(cfg, stats)
+	formats := params["format"]
+	if formats == "" {
+		formats = "json"
+	}
+	var exported []string
+	for _, format := range strings.Split(formats, ",") {
+		if format = strings.TrimSpace(format); format != "" {
+			if file := exportResults(results, format); file != "" {
+				exported = append(exported, file)
+			}
+		}
+	}
+	recordRun(runRecord{
+		Mode:     "search",
+		Start:    stats.StartTime,
+		Duration: time.Since(stats.StartTime).Round(time.Second).String(),
+		Params:   map[string]string{"target": fmt.Sprint(target), "source": "daemon"},
+		Counts:   map[string]int{"stores": len(results), "errors": stats.Errors},
+		Exports:  exported,
+		Series:   stats.Series(),
+	})
+	return fmt.Sprintf("%d stores found, %d errors, %d export(s)",
+		len(results), stats.Errors, len(exported)), nil
+}
+
+// jobRevalidate re-checks every store in a saved results file and writes
+// the refreshed set back out as JSON.
+func jobRevalidate(params map[string]string) (string, error) {
+	input := params["input"]
+	if input == "" {
+		return "", fmt.Errorf(`revalidate needs an "input" param (results JSON file)`)
+	}
+	results, err := LoadResultsJSON(input)
+	if err != nil {
+		return "", err
+	}
+	validator := newStoreValidator(0)
+	workers := config.Threads
+	if workers < 1 {
+		workers = 10
+	}
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	failures := 0
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := validator.Validate(appCtx, &results[i]); err != nil {
+					mu.Lock()
+					failures++
+					mu.Unlock()
+				}
+			}
+		}()
+	}
+	for i := range results {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	file := exportResults(results, "json")
+	if file == "" {
+		return "", fmt.Errorf("could not save revalidated results")
+	}
+	return fmt.Sprintf("%d stores revalidated (%d errors), saved %s",
+		len(results), failures, file), nil
+}
+
+// jobExport re-exports a saved results file in another format.
+func jobExport(params map[string]string) (string, error) {
+	input := params["input"]
+	if input == "" {
+		return "", fmt.Errorf(`export needs an "input" param (results JSON file)`)
+	}
+	format := params["format"]
+	if format == "" {
+		format = "json"
+	}
+	results, err := LoadResultsJSON(input)
+	if err != nil {
+		return "", err
+	}
+	file := exportResults(results, format)
+	if file == "" {
+		return "", fmt.Errorf("export to %s failed", format)
+	}
+	return fmt.Sprintf("%d stores exported to %s", len(results), file), nil
+}
+
+// jobRestock imports codes from a file, URL or drop directory, the same
+// way one auto-restock cycle would.
+func jobRestock(params map[string]string) (string, error) {
+	source := params["source"]
+	if source == "" {
+		return "", fmt.Errorf(`restock needs a "source" param (file, URL or directory)`)
+	}
+	journalStock("daemon restock " + source)
+	added, skipped, invalid := restockOnce(source, false)
+	saveStockSnapshot()
+	return fmt.Sprintf("%d added, %d duplicates, %d invalid (total %d)",
+		added, skipped, invalid, store.Count()), nil
+}
+
+// jobPrune drops stores the finder has not seen for a while from the
+// store database.
+func jobPrune(params map[string]string) (string, error) {
+	days, _ := strconv.Atoi(params["days"])
+	if days <= 0 {
+		days = 30
+	}
+	db, err := storedb.Open(storeDBPath())
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+	pruned, err := db.PruneStale(time.Now().AddDate(0, 0, -days))
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%d stores not seen in %dd pruned", pruned, days), nil
+}
+
+// jobBackup writes the full stock to a timestamped file next to the other
+// exports.
+func jobBackup(map[string]string) (string, error) {
+	crowns := store.List()
+	data, err := json.MarshalIndent(crowns, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	file := outputPath("stock_backup", "json")
+	if err := writeFileAtomic(file, data); err != nil {
+		return "", err
+	}
+	uploadExport(file)
+	return fmt.Sprintf("%d crowns backed up to %s", len(crowns), file), nil
+}
+
+// startJobAPI serves the job queue over HTTP: POST /jobs enqueues (bearer
+// restock token required), GET /jobs lists, GET /jobs/<id> fetches one.
+func startJobAPI(addr string) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/jobs", handleJobs)
+	mux.HandleFunc("/jobs/", handleJobByID)
+	server := &http.Server{
+		Addr:         addr,
+		Handler:      mux,
+		ReadTimeout:  time.Minute,
+		WriteTimeout: 30 * time.Second,
+	}
+	go func() {
+		fmt.Printf("📡 Job API on %s\n", addr)
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("\n❌ Job API: %v\n", err)
+		}
+	}()
+	return nil
+}
+
+func handleJobs(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case http.MethodGet:
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(jobSnapshot())
+	case http.MethodPost:
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if subtle.ConstantTimeCompare([]byte(token), []byte(config.RestockToken)) != 1 {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, 1<<20))
+		if err != nil {
+			http.Error(w, "read body: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		var req struct {
+			Kind   string            `json:"kind"`
+			Params map[string]string `json:"params"`
+		}
+		if err := json.Unmarshal(body, &req); err != nil {
+			http.Error(w, "invalid json: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		job, err := enqueueJob(req.Kind, req.Params)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(job)
+	default:
+		http.Error(w, "GET or POST only", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleJobByID(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "GET only", http.StatusMethodNotAllowed)
+		return
+	}
+	id, err := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/jobs/"))
+	if err != nil {
+		http.Error(w, "bad job id", http.StatusBadRequest)
+		return
+	}
+	for _, job := range jobSnapshot() {
+		if job.ID == id {
+			w.Header().Set("Content-Type", "application/json")
+			json.NewEncoder(w).Encode(job)
+			return
+		}
+	}
+	http.Error(w, "no such job", http.StatusNotFound)
+}
+
+func init() {
+	var daemonAddr string
+	var daemonWorkers int
+	daemonCmd := &cobra.Command{
+		Use:   "daemon",
+		Short: "Run as a long-lived worker executing queued jobs",
+		Long: "Runs until interrupted, executing jobs queued over the HTTP job API\n" +
+			"or a Telegram /job command. Kinds: search, revalidate, export, restock.",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if daemonAddr != "" && config.RestockToken == "" {
+				return fmt.Errorf("set restock_token in %s before serving the job API", configPath())
+			}
+			if daemonWorkers < 1 {
+				daemonWorkers = 1
+			}
+			jobQueueMu.Lock()
+			loadJobHistory()
+			jobCh = make(chan *daemonJob, daemonJobsMax)
+			daemonOn = true
+			jobQueueMu.Unlock()
+			for i := 0; i < daemonWorkers; i++ {
+				go daemonWorker()
+			}
+			if daemonAddr != "" {
+				if err := startJobAPI(daemonAddr); err != nil {
+					return err
+				}
+			}
+			if config.TelegramToken != "" && len(config.TrustedUserIDs) > 0 {
+				if err := startStockBot(); err != nil {
+					fmt.Printf("⚠️  Stock bot: %v\n", err)
+				}
+			}
+			fmt.Printf("🔄 Daemon running with %d worker(s); Ctrl+C to stop\n", daemonWorkers)
+			<-appCtx.Done()
+			fmt.Println("\n🏁 Daemon stopping")
+			return nil
+		},
+	}
+	daemonCmd.Flags().StringVar(&daemonAddr, "addr", "",
+		"serve the HTTP job API on this address (e.g. localhost:8090)")
+	daemonCmd.Flags().IntVar(&daemonWorkers, "workers", 1,
+		"jobs executed in parallel (1 = strictly sequential)")
+	rootCmd.AddCommand(daemonCmd)
+}
@@ -0,0 +1,136 @@
+package main
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+//go:embed dashboard.html
+var dashboardHTML []byte
+
+// dashboardState is one snapshot pushed to connected browsers.
+type dashboardState struct {
+	Running   bool                            `json:"running"`
+	Stores    int                             `json:"stores"`
+	Queries   int                             `json:"queries"`
+	Errors    int                             `json:"errors"`
+	Elapsed   string                          `json:"elapsed"`
+	Providers map[string]search.ProviderStats `json:"providers,omitempty"`
+	Results   []StoreResult                   `json:"results,omitempty"`
+	Events    []string                        `json:"events,omitempty"`
+}
+
+// dashboardEvents caps the recent-events feed.
+const dashboardEvents = 20
+
+// dashboardHub tracks the active run so the web UI can render it.
+type dashboardHub struct {
+	mu      sync.Mutex
+	stats   *SearchStats
+	results []StoreResult
+	events  []string
+}
+
+var dashboard dashboardHub
+
+// setRun points the dashboard at a starting run.
+func (h *dashboardHub) setRun(stats *SearchStats) {
+	h.mu.Lock()
+	h.stats = stats
+	h.results = nil
+	h.mu.Unlock()
+}
+
+// setResults publishes the finished run's results and marks the run done.
+func (h *dashboardHub) setResults(results []StoreResult) {
+	h.mu.Lock()
+	h.results = results
+	h.stats = nil
+	h.mu.Unlock()
+}
+
+// noteEvent appends a bus event to the recent-events feed.
+func (h *dashboardHub) noteEvent(e busEvent) {
+	if e.Name == evStoreFound || e.Name == evValidationDone {
+		// Per-item events would drown the feed; the counters cover them.
+		return
+	}
+	h.mu.Lock()
+	line := e.Time.Format("15:04:05") + " " + e.Name
+	h.events = append(h.events, line)
+	if len(h.events) > dashboardEvents {
+		h.events = h.events[len(h.events)-dashboardEvents:]
+	}
+	h.mu.Unlock()
+}
+
+// snapshot builds the state pushed over the WebSocket.
+func (h *dashboardHub) snapshot() dashboardState {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	state := dashboardState{Results: h.results, Events: h.events}
+	if len(state.Results) > 100 {
+		state.Results = state.Results[:100]
+	}
+	if h.stats == nil {
+		return state
+	}
+	// Snapshot copies the buckets: they keep changing while the run is
+	// live and are marshalled after the lock is released.
+	snap := h.stats.Snapshot()
+	state.Running = true
+	state.Stores = snap.Stores
+	state.Queries = snap.Queries
+	state.Errors = snap.Errors
+	state.Elapsed = time.Since(snap.StartTime).Round(time.Second).String()
+	state.Providers = snap.ByProvider
+	return state
+}
+
+var dashboardUpgrader = websocket.Upgrader{}
+
+// handleDashboardWS pushes a state snapshot to the browser every second
+// until it disconnects.
+func handleDashboardWS(w http.ResponseWriter, r *http.Request) {
+	conn, err := dashboardUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		data, err := json.Marshal(dashboard.snapshot())
+		if err != nil {
+			return
+		}
+		if err := conn.WriteMessage(websocket.TextMessage, data); err != nil {
+			return
+		}
+	}
+}
+
+// startDashboard serves the embedded web UI: live search progress,
+// per-provider stats and a results table updating over WebSocket.
+func startDashboard(addr string) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(dashboardHTML)
+	})
+	mux.HandleFunc("/ws", handleDashboardWS)
+	go func() {
+		fmt.Printf("🔍 Dashboard on http://%s/\n", addr)
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			fmt.Printf("⚠️  Dashboard server: %v\n", err)
+		}
+	}()
+}
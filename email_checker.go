@@ -0,0 +1,97 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/validate"
+)
+
+func emailCheckerMode() {
+	clearScreen()
+	fmt.Println("═══════════ EMAIL LIST CHECKER ═══════════")
+	path := promptLine("Email list file: ")
+	recordSession("check", map[string]string{"file": path})
+	checkEmails(path, new(CheckerStats))
+	pause()
+}
+
+// checkEmails validates every email in the file (syntax + MX lookup on the
+// domain) and writes the valid ones to valid_emails.txt. Counts go into
+// the caller's stats and are folded into the session totals at the end.
+func checkEmails(path string, stats *CheckerStats) {
+	lines, progress, readErr, err := streamLines(path)
+	if err != nil {
+		fmt.Printf("❌ Cannot open %s: %v\n", path, err)
+		return
+	}
+	outFile := outputPath("valid_emails", "txt")
+	out, err := os.Create(outFile)
+	if err != nil {
+		fmt.Printf("❌ Cannot create output: %v\n", err)
+		return
+	}
+	defer out.Close()
+	w := bufio.NewWriter(out)
+	defer w.Flush()
+
+	start := time.Now()
+	// Per-run map in front of the persistent "mx" bucket: repeated domains
+	// within a list skip even the cache file.
+	mxCache := make(map[string]bool)
+	for email := range lines {
+		if interrupted() {
+			fmt.Println("\n⚠️  Interrupted — flushing what was checked so far")
+			break
+		}
+		stats.Checked++
+		if stats.Checked%streamProgressEvery == 0 {
+			fmt.Printf("⌛ %d checked — %s read\n", stats.Checked, progress)
+		}
+		if !validate.EmailSyntax(email) {
+			logItem("❌ %s (bad format)\n", email)
+			continue
+		}
+		domain := validate.EmailDomain(email)
+		ok, cached := mxCache[domain]
+		if !cached {
+			if dryRunSkip("MX lookup " + domain) {
+				mxCache[domain] = false
+				continue
+			}
+			ok = cachedMX(domain, func() bool { return validate.HasMX(appCtx, domain) })
+			mxCache[domain] = ok
+		}
+		if !ok {
+			logItem("❌ %s (no MX for %s)\n", email, domain)
+			continue
+		}
+		stats.Valid++
+		logItem("✅ %s\n", email)
+		fmt.Fprintln(w, email)
+	}
+	if err := readErr(); err != nil {
+		fmt.Printf("⚠️  Read error after %d lines: %v\n", stats.Checked, err)
+	}
+	session.addChecker(stats)
+	bumpLifetimeStats(map[string]int{
+		"checker.checked": stats.Checked,
+		"checker.valid":   stats.Valid,
+	})
+	recordRun(runRecord{
+		Mode:     "check",
+		Start:    start,
+		Duration: time.Since(start).Round(time.Second).String(),
+		Params:   map[string]string{"file": path},
+		Counts: map[string]int{
+			"checked": stats.Checked,
+			"valid":   stats.Valid,
+		},
+		Exports: []string{outFile},
+	})
+	logger.Info("check finished", "file", path,
+		"checked", stats.Checked, "valid", stats.Valid)
+	fmt.Printf("\n🏁 Checked %d, valid %d → %s\n", stats.Checked, stats.Valid, outFile)
+}
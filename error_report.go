@@ -0,0 +1,55 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// saveErrorReport prints the aggregated error breakdown for a finished
+// run — per provider and class, with one sample message each — and writes
+// the full collected list to an errors export so failures stay diagnosable
+// after the terminal scrolls away.
+func saveErrorReport(stats *SearchStats) {
+	entries := stats.Snapshot().ErrorLog
+	if len(entries) == 0 {
+		return
+	}
+
+	type bucket struct {
+		count  int
+		sample string
+	}
+	byGroup := make(map[string]*bucket)
+	for _, e := range entries {
+		key := e.Provider + "/" + e.Class
+		b := byGroup[key]
+		if b == nil {
+			b = &bucket{sample: e.Message}
+			byGroup[key] = b
+		}
+		b.count++
+	}
+	groups := make([]string, 0, len(byGroup))
+	for key := range byGroup {
+		groups = append(groups, key)
+	}
+	sort.Slice(groups, func(i, j int) bool { return byGroup[groups[i]].count > byGroup[groups[j]].count })
+
+	fmt.Printf("⚠️  Error report (%d errors):\n", len(entries))
+	for _, key := range groups {
+		b := byGroup[key]
+		fmt.Printf("   %-30s %4d  e.g. %s\n", key, b.count, b.sample)
+	}
+
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return
+	}
+	path := outputPath("errors", "json")
+	if err := writeFileAtomic(path, data); err != nil {
+		fmt.Printf("⚠️  Could not write error report: %v\n", err)
+		return
+	}
+	fmt.Printf("💾 Full error list saved to %s\n", path)
+}
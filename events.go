@@ -0,0 +1,84 @@
+package main
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// webhookEvent is the JSON payload posted for every emitted event.
+type webhookEvent struct {
+	Event string                 `json:"event"`
+	Time  time.Time              `json:"time"`
+	Data  map[string]interface{} `json:"data,omitempty"`
+}
+
+// emitEvent publishes an event on the in-process bus; the subscribers
+// wired in initEventBus take it from there (webhook, dashboard, stock
+// checks).
+func emitEvent(name string, data map[string]interface{}) {
+	bus.publish(name, data)
+}
+
+// postEventWebhook forwards a bus event to the configured webhook,
+// signing the body with HMAC-SHA256 when a secret is set. Delivery
+// failures only warn; they never abort the run that produced the event.
+func postEventWebhook(e busEvent) {
+	name := e.Name
+	if config.EventWebhookURL == "" {
+		return
+	}
+	if dryRunSkip(fmt.Sprintf("POST event %s to %s", name, config.EventWebhookURL)) {
+		return
+	}
+	body, err := json.Marshal(webhookEvent{Event: name, Time: e.Time, Data: e.Data})
+	if err != nil {
+		return
+	}
+	req, err := http.NewRequestWithContext(appCtx, "POST", config.EventWebhookURL, bytes.NewReader(body))
+	if err != nil {
+		fmt.Printf("⚠️  Event webhook: %v\n", err)
+		return
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Event", name)
+	if config.EventWebhookSecret != "" {
+		mac := hmac.New(sha256.New, []byte(config.EventWebhookSecret))
+		mac.Write(body)
+		req.Header.Set("X-Signature", hex.EncodeToString(mac.Sum(nil)))
+	}
+	resp, err := newHTTPClient(15 * time.Second).Do(req)
+	if err != nil {
+		fmt.Printf("⚠️  Event webhook: %v\n", err)
+		return
+	}
+	resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		fmt.Printf("⚠️  Event webhook: status %d for %s\n", resp.StatusCode, name)
+	}
+}
+
+// checkStockLow fires a stock_low event when the unused count drops below
+// the configured threshold.
+func checkStockLow() {
+	if config.StockLowThreshold <= 0 {
+		return
+	}
+	unused := 0
+	for _, c := range store.List() {
+		if !c.Used {
+			unused++
+		}
+	}
+	if unused < config.StockLowThreshold {
+		emitEvent(evStockLow, map[string]interface{}{
+			"unused":    unused,
+			"threshold": config.StockLowThreshold,
+		})
+	}
+}
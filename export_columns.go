@@ -0,0 +1,12 @@
+package main
+
+import (
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/export"
+)
+
+// selectedColumns returns the columns configured for tabular exports, or
+// every column when export_columns is unset. Unknown names are dropped with
+// a warning so a typo doesn't silently produce an empty export.
+func selectedColumns() []string {
+	return export.SelectColumns(config.ExportColumns)
+}
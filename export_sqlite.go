@@ -0,0 +1,82 @@
+package main
+
+import (
+	"database/sql"
+	"fmt"
+
+	_ "modernc.org/sqlite"
+)
+
+// Canned filters offered by the query menu.
+var cannedQueries = []struct {
+	label string
+	sql   string
+}{
+	{"Top 20 by trust score",
+		`SELECT s.name, s.url, s.trust_score FROM stores s ORDER BY s.trust_score DESC LIMIT 20`},
+	{"Verified stores with PayPal",
+		`SELECT s.name, s.url, s.trust_score FROM stores s
+		 JOIN validations v ON v.store_id = s.id
+		 WHERE v.verified = 1 AND v.has_paypal = 1 ORDER BY s.trust_score DESC`},
+	{"Instant delivery stores",
+		`SELECT s.name, s.url, s.trust_score FROM stores s
+		 JOIN validations v ON v.store_id = s.id
+		 WHERE v.instant_delivery = 1 ORDER BY s.trust_score DESC`},
+	{"Store counts per source",
+		`SELECT s.source, COUNT(*) FROM stores s GROUP BY s.source ORDER BY COUNT(*) DESC`},
+}
+
+// queryResultsMenu runs canned SQL filters against an exported database.
+func queryResultsMenu() {
+	clearScreen()
+	fmt.Println("═══════════ QUERY RESULTS ═══════════")
+	path := promptLine(fmt.Sprintf("Database file (default %s): ", storeDBPath()))
+	if path == "" {
+		path = storeDBPath()
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		fmt.Printf("❌ Cannot open %s: %v\n", path, err)
+		pause()
+		return
+	}
+	defer db.Close()
+
+	for i, q := range cannedQueries {
+		fmt.Printf("[%d] %s\n", i+1, q.label)
+	}
+	choice := promptChoice("Query: ", 1, len(cannedQueries))
+
+	rows, err := db.Query(cannedQueries[choice-1].sql)
+	if err != nil {
+		fmt.Printf("❌ Query failed: %v\n", err)
+		pause()
+		return
+	}
+	defer rows.Close()
+
+	cols, _ := rows.Columns()
+	count := 0
+	for rows.Next() {
+		values := make([]interface{}, len(cols))
+		ptrs := make([]interface{}, len(cols))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		if err := rows.Scan(ptrs...); err != nil {
+			fmt.Printf("❌ Scan failed: %v\n", err)
+			break
+		}
+		for i, v := range values {
+			if i > 0 {
+				fmt.Print(" | ")
+			}
+			fmt.Printf("%v", v)
+		}
+		fmt.Println()
+		count++
+	}
+	fmt.Printf("\n%d rows\n", count)
+	pause()
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/export"
+)
+
+// SaveResultsTemplate renders the results through a user-supplied
+// text/template file, so custom TXT/HTML/Markdown layouts need no code
+// changes.
+func SaveResultsTemplate(results []StoreResult, templatePath, outPath string) error {
+	return export.SaveResultsTemplate(results, templatePath, outPath)
+}
+
+// templateOutputPath derives the export path from the template name:
+// "report.md.tmpl" renders to outputPath("report", "md").
+func templateOutputPath(templatePath string) string {
+	base := strings.TrimSuffix(filepath.Base(templatePath), ".tmpl")
+	ext := strings.TrimPrefix(filepath.Ext(base), ".")
+	stem := strings.TrimSuffix(base, filepath.Ext(base))
+	if ext == "" {
+		ext = "txt"
+	}
+	return outputPath(stem, ext)
+}
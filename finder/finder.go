@@ -0,0 +1,57 @@
+// Package finder exposes the CD-key store finder as an importable
+// library: discover stores across the known providers, validate them, and
+// get the results back as plain values. The interactive CrownPal binary
+// drives the same engine with its own hooks; this facade wires sensible
+// defaults for programmatic use.
+//
+//	results, stats := finder.Search(ctx, finder.Config{TargetStores: 100})
+package finder
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/validate"
+)
+
+// Store is a single discovered CD-key store.
+type Store = search.Result
+
+// Config controls a search run.
+type Config = search.Config
+
+// Stats carries the live and final counters of a run.
+type Stats = search.Stats
+
+// Statistics about errors and providers use the engine's types directly.
+type (
+	Snapshot      = search.Snapshot
+	ProviderStats = search.ProviderStats
+	ErrorEntry    = search.ErrorEntry
+)
+
+// Search runs the scraping and validation pipeline with default HTTP
+// clients and returns up to cfg.TargetStores results together with the
+// run's stats. Cancelling ctx returns the partial results collected so
+// far. Zero-value fields get the defaults the CLI uses.
+func Search(ctx context.Context, cfg Config) ([]Store, *Stats) {
+	if cfg.MaxWorkers <= 0 {
+		cfg.MaxWorkers = 10
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = 15 * time.Second
+	}
+	if cfg.RateLimitPerSec <= 0 {
+		cfg.RateLimitPerSec = 5
+	}
+	if cfg.TargetStores <= 0 {
+		cfg.TargetStores = 100
+	}
+	stats := search.NewStats()
+	scraper := search.NewScraper(&http.Client{Timeout: cfg.Timeout}, nil, nil, search.Env{})
+	validator := validate.NewStore(&http.Client{Timeout: 10 * time.Second}, search.Env{})
+	results := search.Run(ctx, cfg, stats, scraper, validator, nil)
+	return results, stats
+}
@@ -0,0 +1,355 @@
+package main
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/encoding"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// jsonCodec carries the messages of proto/crownpal.proto in their proto3
+// JSON form, which for these messages matches the app's existing JSON
+// tags. That keeps the service free of generated stubs and the build free
+// of a protoc step; clients opt in with the "json" call content subtype.
+type jsonCodec struct{}
+
+func (jsonCodec) Marshal(v interface{}) ([]byte, error)      { return json.Marshal(v) }
+func (jsonCodec) Unmarshal(data []byte, v interface{}) error { return json.Unmarshal(data, v) }
+func (jsonCodec) Name() string                               { return "json" }
+
+func init() { encoding.RegisterCodec(jsonCodec{}) }
+
+// The wire messages mirror proto/crownpal.proto; Store, Crown and Run
+// reuse the app types whose JSON layout already matches.
+type grpcSearchRequest struct {
+	Target int `json:"target"`
+}
+
+type grpcSearchProgress struct {
+	Stores         int           `json:"stores"`
+	Queries        int           `json:"queries"`
+	Duplicates     int           `json:"duplicates"`
+	Errors         int           `json:"errors"`
+	ElapsedSeconds int64         `json:"elapsed_seconds"`
+	Done           bool          `json:"done"`
+	Results        []StoreResult `json:"results,omitempty"`
+}
+
+type grpcListStockRequest struct {
+	Pool string `json:"pool"`
+}
+
+type grpcListStockReply struct {
+	Crowns []Crown `json:"crowns"`
+}
+
+type grpcAddCrownsRequest struct {
+	Crowns []Crown `json:"crowns"`
+	Pool   string  `json:"pool"`
+}
+
+type grpcAddCrownsReply struct {
+	Added   int `json:"added"`
+	Skipped int `json:"skipped"`
+	Invalid int `json:"invalid"`
+	Total   int `json:"total"`
+}
+
+type grpcDispenseRequest struct {
+	Pool string `json:"pool"`
+}
+
+type grpcDispenseReply struct {
+	Crown Crown `json:"crown"`
+}
+
+type grpcListRunsRequest struct {
+	Limit int `json:"limit"`
+}
+
+type grpcListRunsReply struct {
+	Runs []runRecord `json:"runs"`
+}
+
+// crownPalService is the server API of the CrownPal service.
+type crownPalService interface {
+	Search(*grpcSearchRequest, grpc.ServerStream) error
+	ListStock(context.Context, *grpcListStockRequest) (*grpcListStockReply, error)
+	AddCrowns(context.Context, *grpcAddCrownsRequest) (*grpcAddCrownsReply, error)
+	Dispense(context.Context, *grpcDispenseRequest) (*grpcDispenseReply, error)
+	ListRuns(context.Context, *grpcListRunsRequest) (*grpcListRunsReply, error)
+}
+
+// grpcServer implements the CrownPal service over the live store and the
+// search engine.
+type grpcServer struct{}
+
+// grpcListener guards against starting the server twice.
+var (
+	grpcListenerMu sync.Mutex
+	grpcListenerOn bool
+)
+
+// startGRPCServer serves the CrownPal gRPC API on addr. Mutating methods
+// check the restock token per call, so the server can start before a
+// token is configured.
+func startGRPCServer(addr string) error {
+	grpcListenerMu.Lock()
+	defer grpcListenerMu.Unlock()
+	if grpcListenerOn {
+		return fmt.Errorf("gRPC server already running")
+	}
+	lis, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	server := grpc.NewServer(grpc.ForceServerCodec(jsonCodec{}))
+	server.RegisterService(&crownPalServiceDesc, &grpcServer{})
+	grpcListenerOn = true
+	go func() {
+		fmt.Printf("🔍 gRPC API on %s\n", addr)
+		if err := server.Serve(lis); err != nil {
+			fmt.Printf("⚠️  gRPC server: %v\n", err)
+		}
+		grpcListenerMu.Lock()
+		grpcListenerOn = false
+		grpcListenerMu.Unlock()
+	}()
+	return nil
+}
+
+// grpcAuthorize checks the bearer token on mutating and code-revealing
+// methods, against the same shared secret the HTTP restock listener uses.
+func grpcAuthorize(ctx context.Context) error {
+	if config.RestockToken == "" {
+		return status.Errorf(codes.FailedPrecondition, "set restock_token in %s first", configPath())
+	}
+	token := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if vals := md.Get("authorization"); len(vals) > 0 {
+			token = strings.TrimPrefix(vals[0], "Bearer ")
+		}
+	}
+	if subtle.ConstantTimeCompare([]byte(token), []byte(config.RestockToken)) != 1 {
+		return status.Error(codes.Unauthenticated, "unauthorized")
+	}
+	return nil
+}
+
+// Search runs the finder and streams progress roughly once a second; the
+// final message carries the results. Client disconnect cancels the run.
+func (s *grpcServer) Search(req *grpcSearchRequest, stream grpc.ServerStream) error {
+	target := req.Target
+	if target <= 0 {
+		target = 100
+	}
+	fmt.Printf("\n🔍 gRPC search: target %d\n", target)
+	cfg := SearchConfig{
+		MaxWorkers:      config.Threads,
+		Timeout:         15 * time.Second,
+		RateLimitPerSec: 5,
+		TargetStores:    target,
+	}
+	stats := search.NewStats()
+	ctx := stream.Context()
+	done := make(chan []StoreResult, 1)
+	go func() {
+		done <- search.Run(ctx, cfg, stats, newSearchScraper(cfg.Timeout), newStoreValidator(cfg.Timeout), nil)
+	}()
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case results := <-done:
+			snap := stats.Snapshot()
+			recordRun(runRecord{
+				Mode:     "search",
+				Start:    snap.StartTime,
+				Duration: time.Since(snap.StartTime).Round(time.Second).String(),
+				Params:   map[string]string{"target": fmt.Sprint(target), "source": "grpc"},
+				Counts:   map[string]int{"stores": len(results), "errors": snap.Errors},
+				Series:   stats.Series(),
+			})
+			return stream.SendMsg(&grpcSearchProgress{
+				Stores:         len(results),
+				Queries:        snap.Queries,
+				Duplicates:     snap.Duplicates,
+				Errors:         snap.Errors,
+				ElapsedSeconds: int64(time.Since(snap.StartTime).Seconds()),
+				Done:           true,
+				Results:        results,
+			})
+		case <-ticker.C:
+			snap := stats.Snapshot()
+			err := stream.SendMsg(&grpcSearchProgress{
+				Stores:         snap.Stores,
+				Queries:        snap.Queries,
+				Duplicates:     snap.Duplicates,
+				Errors:         snap.Errors,
+				ElapsedSeconds: int64(time.Since(snap.StartTime).Seconds()),
+			})
+			if err != nil {
+				return err
+			}
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+// ListStock returns the stock, optionally narrowed to one pool. It hands
+// out full codes, so it is gated like the mutating methods.
+func (s *grpcServer) ListStock(ctx context.Context, req *grpcListStockRequest) (*grpcListStockReply, error) {
+	if err := grpcAuthorize(ctx); err != nil {
+		return nil, err
+	}
+	crowns := store.List()
+	if req.Pool != "" {
+		var filtered []Crown
+		for _, c := range crowns {
+			if c.Pool == req.Pool {
+				filtered = append(filtered, c)
+			}
+		}
+		crowns = filtered
+	}
+	return &grpcListStockReply{Crowns: crowns}, nil
+}
+
+// AddCrowns merges the pushed crowns into stock, validating each code the
+// way every other import path does.
+func (s *grpcServer) AddCrowns(ctx context.Context, req *grpcAddCrownsRequest) (*grpcAddCrownsReply, error) {
+	if err := grpcAuthorize(ctx); err != nil {
+		return nil, err
+	}
+	var batch []Crown
+	invalid := 0
+	for _, c := range req.Crowns {
+		if c.Type == "" {
+			c.Type = "generic"
+		}
+		if validateCode(c.Type, c.Code) != nil {
+			invalid++
+			continue
+		}
+		if c.AddedAt.IsZero() {
+			c.AddedAt = time.Now()
+		}
+		if req.Pool != "" {
+			c.Pool = req.Pool
+		}
+		batch = append(batch, c)
+	}
+	journalStock("grpc restock")
+	added, skipped := store.Add(batch...)
+	fmt.Printf("\n📦 gRPC restock: %d added, %d duplicates, %d invalid\n", added, skipped, invalid)
+	return &grpcAddCrownsReply{
+		Added:   added,
+		Skipped: skipped,
+		Invalid: invalid,
+		Total:   store.Count(),
+	}, nil
+}
+
+// Dispense marks and returns the oldest unused crown in the pool.
+func (s *grpcServer) Dispense(ctx context.Context, req *grpcDispenseRequest) (*grpcDispenseReply, error) {
+	if err := grpcAuthorize(ctx); err != nil {
+		return nil, err
+	}
+	journalStock("grpc dispense")
+	c, err := store.Dispense(req.Pool)
+	if err != nil {
+		if errors.Is(err, errPoolEmpty) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	fmt.Printf("\n🎁 gRPC dispensed %s (%s)\n", c.Code, c.Type)
+	return &grpcDispenseReply{Crown: c}, nil
+}
+
+// ListRuns returns the recorded run history, newest first.
+func (s *grpcServer) ListRuns(ctx context.Context, req *grpcListRunsRequest) (*grpcListRunsReply, error) {
+	runs := loadRuns()
+	// Newest first, capped to the requested limit.
+	reversed := make([]runRecord, 0, len(runs))
+	for i := len(runs) - 1; i >= 0; i-- {
+		reversed = append(reversed, runs[i])
+	}
+	if req.Limit > 0 && len(reversed) > req.Limit {
+		reversed = reversed[:req.Limit]
+	}
+	return &grpcListRunsReply{Runs: reversed}, nil
+}
+
+// The handlers below are what protoc-gen-go-grpc would emit, minus the
+// interceptor plumbing this server never registers.
+
+func grpcSearchHandler(srv interface{}, stream grpc.ServerStream) error {
+	var req grpcSearchRequest
+	if err := stream.RecvMsg(&req); err != nil {
+		return err
+	}
+	return srv.(crownPalService).Search(&req, stream)
+}
+
+func grpcListStockHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(grpcListStockRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(crownPalService).ListStock(ctx, req)
+}
+
+func grpcAddCrownsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(grpcAddCrownsRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(crownPalService).AddCrowns(ctx, req)
+}
+
+func grpcDispenseHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(grpcDispenseRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(crownPalService).Dispense(ctx, req)
+}
+
+func grpcListRunsHandler(srv interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+	req := new(grpcListRunsRequest)
+	if err := dec(req); err != nil {
+		return nil, err
+	}
+	return srv.(crownPalService).ListRuns(ctx, req)
+}
+
+var crownPalServiceDesc = grpc.ServiceDesc{
+	ServiceName: "crownpal.v1.CrownPal",
+	HandlerType: (*crownPalService)(nil),
+	Methods: []grpc.MethodDesc{
+		{MethodName: "ListStock", Handler: grpcListStockHandler},
+		{MethodName: "AddCrowns", Handler: grpcAddCrownsHandler},
+		{MethodName: "Dispense", Handler: grpcDispenseHandler},
+		{MethodName: "ListRuns", Handler: grpcListRunsHandler},
+	},
+	Streams: []grpc.StreamDesc{
+		{StreamName: "Search", Handler: grpcSearchHandler, ServerStreams: true},
+	},
+	Metadata: "proto/crownpal.proto",
+}
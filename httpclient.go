@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+var (
+	sharedTransport     *http.Transport
+	sharedTransportOnce sync.Once
+)
+
+// proxyFunc builds the transport's proxy selector. With no configured
+// proxies it falls back to the environment; otherwise every request
+// rotates through the list so load spreads across the pool. Entries
+// without a scheme default to http://.
+func proxyFunc() func(*http.Request) (*url.URL, error) {
+	var proxies []*url.URL
+	for _, raw := range config.Proxies {
+		if !strings.Contains(raw, "://") {
+			raw = "http://" + raw
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			fmt.Printf("⚠️  Ignoring invalid proxy %q: %v\n", raw, err)
+			continue
+		}
+		proxies = append(proxies, u)
+	}
+	if len(proxies) == 0 {
+		return http.ProxyFromEnvironment
+	}
+	var next uint32
+	return func(*http.Request) (*url.URL, error) {
+		return proxies[atomic.AddUint32(&next, 1)%uint32(len(proxies))], nil
+	}
+}
+
+// tunedTransport returns the process-wide HTTP transport, sized for
+// high-concurrency runs. The default transport caps idle connections at two
+// per host, which stalls badly with MaxWorkers=100 hitting the same targets.
+func tunedTransport() *http.Transport {
+	sharedTransportOnce.Do(func() {
+		sharedTransport = &http.Transport{
+			Proxy: proxyFunc(),
+			DialContext: (&net.Dialer{
+				Timeout:   10 * time.Second,
+				KeepAlive: 30 * time.Second,
+			}).DialContext,
+			ForceAttemptHTTP2:     config.HTTP2,
+			MaxIdleConns:          200,
+			MaxIdleConnsPerHost:   100,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ExpectContinueTimeout: 1 * time.Second,
+		}
+	})
+	return sharedTransport
+}
+
+// newHTTPClient builds a client on the shared tuned transport.
+func newHTTPClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: tunedTransport(),
+		Timeout:   timeout,
+	}
+}
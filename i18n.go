@@ -0,0 +1,98 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+)
+
+// lang is the active UI language, picked by initLocale.
+var lang = "en"
+
+// messages is the UI string catalog. Keys are stable identifiers; a key
+// missing from the active language falls back to English, and a key
+// missing everywhere is shown as-is so a typo is visible, not fatal.
+var messages = map[string]map[string]string{
+	"en": {
+		"menu.title":       "CROWNPAL v1.0",
+		"menu.finder":      "CD-Key Store Finder",
+		"menu.checker":     "Email List Checker",
+		"menu.stock":       "Crown Stock Management",
+		"menu.telegram":    "Telegram Channel",
+		"menu.stats":       "Statistics",
+		"menu.query":       "Query Results DB",
+		"menu.compare":     "Compare Result Files",
+		"menu.import":      "Import Store List",
+		"menu.profile":     "Switch Profile",
+		"menu.history":     "Session History",
+		"menu.repeat":      "Repeat Last Run",
+		"menu.runs":        "Run History",
+		"menu.exit":        "Exit",
+		"menu.keys":        "↑/↓ move · enter select · q quit",
+		"prompt.choice":    "Choice: ",
+		"prompt.continue":  "Press Enter to continue...",
+		"msg.bye":          "Bye!",
+		"err.number_range": "❌ Enter a number between %d and %d",
+		"stats.header":     "═══════════ STATISTICS ═══════════",
+		"stats.checked":    "Checked accounts: %d",
+		"stats.valid":      "Valid emails:     %d",
+		"stats.stock":      "Stock items:      %d",
+	},
+	"ru": {
+		"menu.title":       "CROWNPAL v1.0",
+		"menu.finder":      "Поиск CD-key магазинов",
+		"menu.checker":     "Проверка списка email",
+		"menu.stock":       "Управление стоком crown",
+		"menu.telegram":    "Telegram канал",
+		"menu.stats":       "Статистика",
+		"menu.query":       "Запросы к БД результатов",
+		"menu.compare":     "Сравнить файлы результатов",
+		"menu.import":      "Импорт списка магазинов",
+		"menu.profile":     "Сменить профиль",
+		"menu.history":     "История сессий",
+		"menu.repeat":      "Повторить последний запуск",
+		"menu.runs":        "История запусков",
+		"menu.exit":        "Выход",
+		"menu.keys":        "↑/↓ выбор · enter открыть · q выход",
+		"prompt.choice":    "Выбор: ",
+		"prompt.continue":  "Нажмите Enter для продолжения...",
+		"msg.bye":          "Пока!",
+		"err.number_range": "❌ Введите число от %d до %d",
+		"stats.header":     "═══════════ СТАТИСТИКА ═══════════",
+		"stats.checked":    "Проверено аккаунтов: %d",
+		"stats.valid":      "Валидных email:      %d",
+		"stats.stock":      "Позиций в стоке:     %d",
+	},
+}
+
+// initLocale picks the UI language from config.Language, falling back to
+// the LANG environment variable ("ru_RU.UTF-8" → "ru").
+func initLocale() {
+	pick := config.Language
+	if pick == "" {
+		env := os.Getenv("LANG")
+		if i := strings.IndexAny(env, "_."); i > 0 {
+			env = env[:i]
+		}
+		pick = strings.ToLower(env)
+	}
+	if _, ok := messages[pick]; ok {
+		lang = pick
+	}
+}
+
+// tr returns the catalog string for key in the active language.
+func tr(key string) string {
+	if s, ok := messages[lang][key]; ok {
+		return s
+	}
+	if s, ok := messages["en"][key]; ok {
+		return s
+	}
+	return key
+}
+
+// trf is tr with fmt.Sprintf formatting.
+func trf(key string, args ...interface{}) string {
+	return fmt.Sprintf(tr(key), args...)
+}
@@ -0,0 +1,147 @@
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ImportStoresNDJSON reads newline-delimited JSON store records produced by
+// other tools. Unknown fields are ignored; lines that fail to parse are
+// reported with their line number.
+func ImportStoresNDJSON(path string) ([]StoreResult, error) {
+	f, err := openListInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var results []StoreResult
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for lineNo := 1; scanner.Scan(); lineNo++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var r StoreResult
+		if err := json.Unmarshal([]byte(line), &r); err != nil {
+			fmt.Printf("⚠️  Line %d skipped: %v\n", lineNo, err)
+			continue
+		}
+		results = append(results, normalizeImported(r))
+	}
+	return results, scanner.Err()
+}
+
+// ImportStoresCSV reads a CSV with a header row; only name and url are
+// required, the other known columns are optional.
+func ImportStoresCSV(path string) ([]StoreResult, error) {
+	f, err := openListInput(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = -1
+	header, err := reader.Read()
+	if err != nil {
+		return nil, fmt.Errorf("read header: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, col := range header {
+		index[strings.ToLower(strings.TrimSpace(col))] = i
+	}
+	field := func(record []string, name string) string {
+		i, ok := index[name]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var results []StoreResult
+	for lineNo := 2; ; lineNo++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			fmt.Printf("⚠️  Line %d skipped: %v\n", lineNo, err)
+			continue
+		}
+		r := StoreResult{
+			Name:     field(record, "name"),
+			URL:      field(record, "url"),
+			Source:   field(record, "source"),
+			Category: field(record, "category"),
+		}
+		r.TrustScore, _ = strconv.ParseFloat(field(record, "trust_score"), 64)
+		r.Confidence, _ = strconv.ParseFloat(field(record, "confidence"), 64)
+		r.HasPayPal, _ = strconv.ParseBool(field(record, "paypal"))
+		r.InstantDelivery, _ = strconv.ParseBool(field(record, "instant"))
+		r.Verified, _ = strconv.ParseBool(field(record, "verified"))
+		results = append(results, normalizeImported(r))
+	}
+	return results, nil
+}
+
+// normalizeImported fills the fields every imported record must carry.
+func normalizeImported(r StoreResult) StoreResult {
+	r.Source = "imported"
+	if r.FoundAt.IsZero() {
+		r.FoundAt = time.Now()
+	}
+	return r
+}
+
+// importStoresMenu imports an external store list and merges it into the
+// local store database, skipping records without a URL.
+func importStoresMenu() {
+	clearScreen()
+	fmt.Println("═══════════ IMPORT STORE LIST ═══════════")
+	path := promptLine("File to import (.ndjson/.jsonl or .csv): ")
+
+	var results []StoreResult
+	var err error
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		results, err = ImportStoresCSV(path)
+	default:
+		results, err = ImportStoresNDJSON(path)
+	}
+	if err != nil {
+		fmt.Printf("❌ Import failed: %v\n", err)
+		pause()
+		return
+	}
+
+	var valid []StoreResult
+	for _, r := range results {
+		if r.URL == "" {
+			continue
+		}
+		valid = append(valid, r)
+	}
+	if len(valid) == 0 {
+		fmt.Println("❌ No usable records found")
+		pause()
+		return
+	}
+
+	dbPath := filepath.Join(config.OutputDir, "stores.db")
+	if err := SaveResultsSQLite(valid, dbPath); err != nil {
+		fmt.Printf("❌ Could not write %s: %v\n", dbPath, err)
+	} else {
+		fmt.Printf("✅ Imported %d stores into %s (%d records skipped)\n",
+			len(valid), dbPath, len(results)-len(valid))
+	}
+	pause()
+}
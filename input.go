@@ -0,0 +1,24 @@
+package main
+
+import (
+	"io"
+	"os"
+)
+
+// readListInput reads a list file, with "-" meaning standard input so
+// lists can be piped in (cat list.txt | crownpal stock add --file -).
+func readListInput(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// openListInput opens a list file for streaming reads, with "-" meaning
+// standard input. Closing the stdin reader is a no-op.
+func openListInput(path string) (io.ReadCloser, error) {
+	if path == "-" {
+		return io.NopCloser(stdin), nil
+	}
+	return os.Open(path)
+}
@@ -0,0 +1,76 @@
+package export
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+	"time"
+)
+
+// trustTier buckets a trust score into the bookmark folder names.
+func trustTier(score float64) string {
+	switch {
+	case score >= 80:
+		return "Trusted (80+)"
+	case score >= 50:
+		return "Decent (50-79)"
+	default:
+		return "Unproven (<50)"
+	}
+}
+
+// SaveResultsBookmarks writes verified stores as a Netscape bookmarks HTML
+// file, organized into folders per category and trust tier, importable into
+// any browser.
+func SaveResultsBookmarks(results []Result, filename string) error {
+	verified := FilterByVerified(results)
+
+	// category → tier → stores
+	folders := make(map[string]map[string][]Result)
+	for _, r := range verified {
+		category := r.Category
+		if category == "" {
+			category = "uncategorized"
+		}
+		tier := trustTier(r.TrustScore)
+		if folders[category] == nil {
+			folders[category] = make(map[string][]Result)
+		}
+		folders[category][tier] = append(folders[category][tier], r)
+	}
+
+	now := time.Now().Unix()
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE NETSCAPE-Bookmark-file-1>\n")
+	sb.WriteString("<META HTTP-EQUIV=\"Content-Type\" CONTENT=\"text/html; charset=UTF-8\">\n")
+	sb.WriteString("<TITLE>Bookmarks</TITLE>\n<H1>Bookmarks</H1>\n<DL><p>\n")
+	sb.WriteString(fmt.Sprintf("    <DT><H3 ADD_DATE=\"%d\">CD-Key Stores</H3>\n    <DL><p>\n", now))
+
+	categories := make([]string, 0, len(folders))
+	for category := range folders {
+		categories = append(categories, category)
+	}
+	sort.Strings(categories)
+	for _, category := range categories {
+		sb.WriteString(fmt.Sprintf("        <DT><H3 ADD_DATE=\"%d\">%s</H3>\n        <DL><p>\n",
+			now, html.EscapeString(category)))
+		tiers := make([]string, 0, len(folders[category]))
+		for tier := range folders[category] {
+			tiers = append(tiers, tier)
+		}
+		sort.Strings(tiers)
+		for _, tier := range tiers {
+			sb.WriteString(fmt.Sprintf("            <DT><H3 ADD_DATE=\"%d\">%s</H3>\n            <DL><p>\n",
+				now, html.EscapeString(tier)))
+			for _, r := range SortByTrustScore(folders[category][tier]) {
+				sb.WriteString(fmt.Sprintf("                <DT><A HREF=\"%s\" ADD_DATE=\"%d\">%s</A>\n",
+					html.EscapeString(r.URL), r.FoundAt.Unix(), html.EscapeString(r.Name)))
+			}
+			sb.WriteString("            </DL><p>\n")
+		}
+		sb.WriteString("        </DL><p>\n")
+	}
+	sb.WriteString("    </DL><p>\n</DL><p>\n")
+	return WriteFileAtomic(filename, []byte(sb.String()))
+}
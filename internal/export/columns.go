@@ -0,0 +1,83 @@
+package export
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// AllColumns is the canonical column order for tabular exports.
+var AllColumns = []string{
+	"name", "url", "source", "category", "trust_score", "confidence",
+	"paypal", "instant", "verified", "found_at",
+}
+
+// SelectColumns returns the requested columns, or every column when the
+// selection is empty. Unknown names are dropped with a warning so a typo
+// doesn't silently produce an empty export.
+func SelectColumns(configured []string) []string {
+	if len(configured) == 0 {
+		return AllColumns
+	}
+	known := make(map[string]bool, len(AllColumns))
+	for _, c := range AllColumns {
+		known[c] = true
+	}
+	var cols []string
+	for _, c := range configured {
+		if !known[c] {
+			fmt.Printf("⚠️  Unknown export column %q ignored\n", c)
+			continue
+		}
+		cols = append(cols, c)
+	}
+	if len(cols) == 0 {
+		return AllColumns
+	}
+	return cols
+}
+
+// ColumnValue extracts one column of a result as a string.
+func ColumnValue(r Result, col string) string {
+	switch col {
+	case "name":
+		return r.Name
+	case "url":
+		return r.URL
+	case "source":
+		return r.Source
+	case "category":
+		return r.Category
+	case "trust_score":
+		return strconv.FormatFloat(r.TrustScore, 'f', 1, 64)
+	case "confidence":
+		return strconv.FormatFloat(r.Confidence, 'f', 2, 64)
+	case "paypal":
+		return strconv.FormatBool(r.HasPayPal)
+	case "instant":
+		return strconv.FormatBool(r.InstantDelivery)
+	case "verified":
+		return strconv.FormatBool(r.Verified)
+	case "found_at":
+		return r.FoundAt.Format("2006-01-02 15:04")
+	default:
+		return ""
+	}
+}
+
+// ColumnCell extracts one column with its native type for XLSX cells.
+func ColumnCell(r Result, col string) interface{} {
+	switch col {
+	case "trust_score":
+		return r.TrustScore
+	case "confidence":
+		return r.Confidence
+	case "paypal":
+		return r.HasPayPal
+	case "instant":
+		return r.InstantDelivery
+	case "verified":
+		return r.Verified
+	default:
+		return ColumnValue(r, col)
+	}
+}
@@ -0,0 +1,169 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"html"
+	"strings"
+)
+
+const htmlReportTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>CD-Key Stores</title>
+<style>
+body { font-family: system-ui, sans-serif; background: #0d0d0d; color: #eee; margin: 2em; }
+h1 { color: #dc143c; }
+#controls { margin-bottom: 1em; display: flex; gap: 1.5em; align-items: center; }
+input[type=text] { background: #1a1a1a; color: #eee; border: 1px solid #444; padding: 0.4em; }
+table { border-collapse: collapse; width: 100%; }
+th, td { padding: 0.4em 0.7em; border-bottom: 1px solid #333; text-align: left; }
+th { cursor: pointer; color: #dc143c; user-select: none; }
+tr:hover { background: #1a1a1a; }
+.badge { padding: 0.1em 0.5em; border-radius: 3px; font-size: 0.85em; }
+a { color: #6af; }
+</style>
+</head>
+<body>
+<h1>CD-Key Stores (<span id="count"></span>)</h1>
+<details open>
+<summary>Top stores by trust</summary>
+<ol>
+__TOP__
+</ol>
+</details>
+<div id="charts" style="display:flex;gap:2em;flex-wrap:wrap;margin-bottom:1.5em;">
+  <figure><canvas id="confHist" width="320" height="180"></canvas><figcaption>Confidence histogram</figcaption></figure>
+  <figure><canvas id="sourceBars" width="320" height="180"></canvas><figcaption>Stores per source</figcaption></figure>
+  <figure><canvas id="paypalPie" width="180" height="180"></canvas><figcaption>PayPal</figcaption></figure>
+  <figure><canvas id="instantPie" width="180" height="180"></canvas><figcaption>Instant delivery</figcaption></figure>
+</div>
+<div id="controls">
+  <input type="text" id="filter" placeholder="Filter stores...">
+  <label>Min confidence: <input type="range" id="conf" min="0" max="100" value="0">
+  <span id="confval">0.00</span></label>
+</div>
+<table>
+<thead><tr>
+<th data-k="name">Store</th><th data-k="source">Source</th>
+<th data-k="trust_score">Trust</th><th data-k="confidence">Confidence</th>
+<th data-k="has_paypal">PayPal</th><th data-k="instant_delivery">Instant</th>
+<th data-k="verified">Verified</th>
+</tr></thead>
+<tbody id="rows"></tbody>
+</table>
+<script>
+const DATA = __DATA__;
+const STATS = __STATS__;
+const colors = {};
+let hue = 0;
+for (const r of DATA) {
+  if (!(r.source in colors)) { colors[r.source] = "hsl(" + hue + ",70%,45%)"; hue = (hue + 67) % 360; }
+}
+let sortKey = "trust_score", sortAsc = false;
+function yes(b) { return b ? "✅" : "—"; }
+function render() {
+  const q = document.getElementById("filter").value.toLowerCase();
+  const minConf = document.getElementById("conf").value / 100;
+  document.getElementById("confval").textContent = minConf.toFixed(2);
+  const rows = DATA
+    .filter(r => r.confidence >= minConf &&
+      (r.name.toLowerCase().includes(q) || r.url.toLowerCase().includes(q) || r.source.includes(q)))
+    .sort((a, b) => {
+      const x = a[sortKey], y = b[sortKey];
+      return (x < y ? -1 : x > y ? 1 : 0) * (sortAsc ? 1 : -1);
+    });
+  document.getElementById("count").textContent = rows.length;
+  document.getElementById("rows").innerHTML = rows.map(r =>
+    "<tr><td><a href=\"" + r.url + "\">" + r.name + "</a></td>" +
+    "<td><span class=\"badge\" style=\"background:" + colors[r.source] + "\">" + r.source + "</span></td>" +
+    "<td>" + r.trust_score.toFixed(1) + "</td>" +
+    "<td>" + r.confidence.toFixed(2) + "</td>" +
+    "<td>" + yes(r.has_paypal) + "</td>" +
+    "<td>" + yes(r.instant_delivery) + "</td>" +
+    "<td>" + yes(r.verified) + "</td></tr>").join("");
+}
+document.querySelectorAll("th").forEach(th => th.onclick = () => {
+  const k = th.dataset.k;
+  if (sortKey === k) sortAsc = !sortAsc; else { sortKey = k; sortAsc = false; }
+  render();
+});
+document.getElementById("filter").oninput = render;
+document.getElementById("conf").oninput = render;
+render();
+
+// --- tiny embedded chart helpers (no external dependencies) ---
+function ctx2d(id) { const c = document.getElementById(id); return [c.getContext("2d"), c.width, c.height]; }
+function drawBars(id, labels, values, color) {
+  const [g, w, h] = ctx2d(id);
+  const max = Math.max(...values, 1), bw = w / values.length;
+  g.fillStyle = "#888"; g.font = "10px sans-serif";
+  values.forEach((v, i) => {
+    const bh = (h - 24) * v / max;
+    g.fillStyle = color || "hsl(" + (i * 67 % 360) + ",70%,45%)";
+    g.fillRect(i * bw + 3, h - 14 - bh, bw - 6, bh);
+    g.fillStyle = "#aaa";
+    g.fillText(String(labels[i]).slice(0, 10), i * bw + 3, h - 3);
+    g.fillText(v, i * bw + 3, h - 18 - bh);
+  });
+}
+function drawPie(id, yes, no) {
+  const [g, w, h] = ctx2d(id);
+  const total = yes + no || 1, r = Math.min(w, h) / 2 - 6;
+  let start = -Math.PI / 2;
+  [[yes, "#2a2"], [no, "#444"]].forEach(([v, color]) => {
+    const angle = 2 * Math.PI * v / total;
+    g.beginPath(); g.moveTo(w / 2, h / 2);
+    g.arc(w / 2, h / 2, r, start, start + angle); g.closePath();
+    g.fillStyle = color; g.fill();
+    start += angle;
+  });
+  g.fillStyle = "#eee"; g.font = "12px sans-serif";
+  g.fillText(yes + " / " + total, w / 2 - 20, h / 2);
+}
+(function charts() {
+  const bins = new Array(10).fill(0);
+  for (const r of DATA) bins[Math.min(9, Math.floor(r.confidence * 10))]++;
+  drawBars("confHist", bins.map((_, i) => (i / 10).toFixed(1)), bins, "#dc143c");
+  const sources = Object.keys(STATS.by_source || {});
+  drawBars("sourceBars", sources, sources.map(s => STATS.by_source[s]));
+  drawPie("paypalPie", STATS.with_paypal, STATS.total - STATS.with_paypal);
+  drawPie("instantPie", STATS.instant, STATS.total - STATS.instant);
+})();
+</script>
+</body>
+</html>
+`
+
+// ExportToHTML writes a single-file interactive report: the results are
+// embedded as JSON and the table supports client-side sorting, text filter,
+// a confidence slider, and per-source color coding.
+func ExportToHTML(results []Result, filename string) error {
+	data, err := json.Marshal(results)
+	if err != nil {
+		return err
+	}
+	statsData, err := json.Marshal(CalculateStatistics(results))
+	if err != nil {
+		return err
+	}
+	// Keep the embedded JSON from terminating the script block early.
+	var topItems strings.Builder
+	for _, r := range TopStores(results, topReportSize) {
+		topItems.WriteString(fmt.Sprintf("<li><a href=%q>%s</a> — trust %.1f",
+			r.URL, html.EscapeString(r.Name), r.TrustScore))
+		if attrs := TopStoreAttrs(r); attrs != "" {
+			topItems.WriteString(" (" + html.EscapeString(attrs) + ")")
+		}
+		topItems.WriteString("</li>\n")
+	}
+	payload := strings.ReplaceAll(string(data), "</", "<\\/")
+	page := strings.Replace(htmlReportTemplate, "__DATA__", payload, 1)
+	page = strings.Replace(page, "__STATS__", string(statsData), 1)
+	page = strings.Replace(page, "__TOP__", topItems.String(), 1)
+	if err := WriteFileAtomic(filename, []byte(page)); err != nil {
+		return fmt.Errorf("write html report: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/go-pdf/fpdf"
+)
+
+// SaveResultsPDF renders a summary report: headline stats, a per-source bar
+// chart, and the top 50 stores by trust score.
+func SaveResultsPDF(results []Result, filename string) error {
+	stats := CalculateStatistics(results)
+
+	pdf := fpdf.New("P", "mm", "A4", "")
+	pdf.SetTitle("CD-Key Store Report", false)
+	pdf.AddPage()
+
+	pdf.SetFont("Helvetica", "B", 18)
+	pdf.Cell(0, 10, "CD-Key Store Report")
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "", 10)
+	pdf.Cell(0, 8, time.Now().Format("2006-01-02 15:04"))
+	pdf.Ln(12)
+
+	// Summary block.
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.Cell(0, 8, "Summary")
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "", 10)
+	summary := [][2]string{
+		{"Total stores", fmt.Sprintf("%d", stats.Total)},
+		{"With PayPal", fmt.Sprintf("%d", stats.WithPayPal)},
+		{"Instant delivery", fmt.Sprintf("%d", stats.Instant)},
+		{"Verified", fmt.Sprintf("%d", stats.Verified)},
+		{"Avg trust score", fmt.Sprintf("%.1f", stats.AvgTrustScore)},
+		{"Avg confidence", fmt.Sprintf("%.2f", stats.AvgConfidence)},
+	}
+	for _, row := range summary {
+		pdf.CellFormat(50, 6, row[0], "", 0, "L", false, 0, "")
+		pdf.CellFormat(40, 6, row[1], "", 1, "R", false, 0, "")
+	}
+	pdf.Ln(6)
+
+	// Per-source bar chart.
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.Cell(0, 8, "Stores per source")
+	pdf.Ln(8)
+	drawSourceBars(pdf, stats.BySource)
+	pdf.Ln(6)
+
+	// Top stores table.
+	pdf.SetFont("Helvetica", "B", 12)
+	pdf.Cell(0, 8, "Top stores by trust score")
+	pdf.Ln(8)
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.CellFormat(60, 6, "Store", "B", 0, "L", false, 0, "")
+	pdf.CellFormat(80, 6, "URL", "B", 0, "L", false, 0, "")
+	pdf.CellFormat(20, 6, "Trust", "B", 0, "R", false, 0, "")
+	pdf.CellFormat(20, 6, "PayPal", "B", 1, "C", false, 0, "")
+	pdf.SetFont("Helvetica", "", 9)
+	top := SortByTrustScore(results)
+	if len(top) > 50 {
+		top = top[:50]
+	}
+	for _, r := range top {
+		paypal := "no"
+		if r.HasPayPal {
+			paypal = "yes"
+		}
+		pdf.CellFormat(60, 5, truncate(r.Name, 38), "", 0, "L", false, 0, "")
+		pdf.CellFormat(80, 5, truncate(r.URL, 55), "", 0, "L", false, 0, "")
+		pdf.CellFormat(20, 5, fmt.Sprintf("%.1f", r.TrustScore), "", 0, "R", false, 0, "")
+		pdf.CellFormat(20, 5, paypal, "", 1, "C", false, 0, "")
+	}
+
+	return WithAtomicFile(filename, func(tmp *os.File) error {
+		return pdf.Output(tmp)
+	})
+}
+
+// drawSourceBars renders a horizontal bar per source, scaled to the largest.
+func drawSourceBars(pdf *fpdf.Fpdf, bySource map[string]int) {
+	sources := make([]string, 0, len(bySource))
+	max := 1
+	for source, n := range bySource {
+		sources = append(sources, source)
+		if n > max {
+			max = n
+		}
+	}
+	sort.Strings(sources)
+
+	pdf.SetFont("Helvetica", "", 9)
+	pdf.SetFillColor(220, 20, 60)
+	for _, source := range sources {
+		n := bySource[source]
+		pdf.CellFormat(40, 5, source, "", 0, "L", false, 0, "")
+		width := 100 * float64(n) / float64(max)
+		x, y := pdf.GetXY()
+		pdf.Rect(x, y+1, width, 3.5, "F")
+		pdf.SetXY(x+width+2, y)
+		pdf.CellFormat(20, 5, fmt.Sprintf("%d", n), "", 1, "L", false, 0, "")
+	}
+}
+
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	return s[:max-1] + "…"
+}
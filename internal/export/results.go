@@ -0,0 +1,270 @@
+// Package export turns search results into files: filters, aggregate
+// statistics and every supported output format. Writers take everything
+// they need as parameters; paths and configuration stay with the caller.
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// Result is the store record every writer in this package consumes.
+type Result = search.Result
+
+// FilterByPayPal keeps only stores that accept PayPal.
+func FilterByPayPal(results []Result) []Result {
+	var out []Result
+	for _, r := range results {
+		if r.HasPayPal {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// FilterByConfidence keeps stores at or above the given confidence.
+func FilterByConfidence(results []Result, min float64) []Result {
+	var out []Result
+	for _, r := range results {
+		if r.Confidence >= min {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// FilterByVerified keeps only verified stores.
+func FilterByVerified(results []Result) []Result {
+	var out []Result
+	for _, r := range results {
+		if r.Verified {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// FilterBySource keeps stores discovered by the given source.
+func FilterBySource(results []Result, source string) []Result {
+	var out []Result
+	for _, r := range results {
+		if r.Source == source {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// SortByTrustScore returns a copy sorted by trust score, best first.
+func SortByTrustScore(results []Result) []Result {
+	out := make([]Result, len(results))
+	copy(out, results)
+	sort.Slice(out, func(i, j int) bool { return out[i].TrustScore > out[j].TrustScore })
+	return out
+}
+
+// Statistics summarizes a result set.
+type Statistics struct {
+	Total         int            `json:"total"`
+	WithPayPal    int            `json:"with_paypal"`
+	Instant       int            `json:"instant"`
+	Verified      int            `json:"verified"`
+	AvgTrustScore float64        `json:"avg_trust_score"`
+	AvgConfidence float64        `json:"avg_confidence"`
+	BySource      map[string]int `json:"by_source"`
+	ByCategory    map[string]int `json:"by_category"`
+}
+
+// CalculateStatistics aggregates counts and averages over results.
+func CalculateStatistics(results []Result) Statistics {
+	stats := Statistics{
+		Total:      len(results),
+		BySource:   make(map[string]int),
+		ByCategory: make(map[string]int),
+	}
+	for _, r := range results {
+		if r.HasPayPal {
+			stats.WithPayPal++
+		}
+		if r.InstantDelivery {
+			stats.Instant++
+		}
+		if r.Verified {
+			stats.Verified++
+		}
+		stats.AvgTrustScore += r.TrustScore
+		stats.AvgConfidence += r.Confidence
+		stats.BySource[r.Source]++
+		stats.ByCategory[r.Category]++
+	}
+	if stats.Total > 0 {
+		stats.AvgTrustScore /= float64(stats.Total)
+		stats.AvgConfidence /= float64(stats.Total)
+	}
+	return stats
+}
+
+// ResultsSchemaVersion is stamped into every saved results file. Bump it
+// when Result changes in a way old readers would misparse, and teach
+// DecodeResults how to lift the previous version.
+//
+// History:
+//
+//	v1: bare []Result array, before files carried a version
+//	v2: {"schema_version", "saved_at", "results"} envelope
+const ResultsSchemaVersion = 2
+
+// resultsFile is the on-disk envelope SaveResultsJSON writes.
+type resultsFile struct {
+	SchemaVersion int      `json:"schema_version"`
+	SavedAt       string   `json:"saved_at"`
+	Results       []Result `json:"results"`
+}
+
+// SaveResultsJSON writes results as indented JSON.
+func SaveResultsJSON(results []Result, filename string) error {
+	data, err := json.MarshalIndent(resultsFile{
+		SchemaVersion: ResultsSchemaVersion,
+		SavedAt:       time.Now().Format(time.RFC3339),
+		Results:       results,
+	}, "", "  ")
+	if err != nil {
+		return err
+	}
+	return WriteFileAtomic(filename, data)
+}
+
+// LoadResultsJSON reads a result file written by any past or present
+// SaveResultsJSON.
+func LoadResultsJSON(filename string) ([]Result, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	results, err := DecodeResults(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, err)
+	}
+	return results, nil
+}
+
+// DecodeResults parses results in any schema version this build knows,
+// migrating older layouts to the current Result shape.
+func DecodeResults(data []byte) ([]Result, error) {
+	trimmed := strings.TrimLeft(string(data), " \t\r\n")
+	if strings.HasPrefix(trimmed, "[") {
+		// v1 files are a bare array; every field it had still decodes.
+		var results []Result
+		if err := json.Unmarshal(data, &results); err != nil {
+			return nil, err
+		}
+		return results, nil
+	}
+	var file resultsFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+	switch {
+	case file.SchemaVersion == ResultsSchemaVersion:
+		return file.Results, nil
+	case file.SchemaVersion > ResultsSchemaVersion:
+		return nil, fmt.Errorf("results schema v%d is newer than this build reads (v%d)",
+			file.SchemaVersion, ResultsSchemaVersion)
+	default:
+		return nil, fmt.Errorf("not a results file (schema_version %d)", file.SchemaVersion)
+	}
+}
+
+// SaveResultsTXT writes a plain-text listing, one store per line, headed
+// by the ranked top-store report.
+func SaveResultsTXT(results []Result, filename string) error {
+	var sb strings.Builder
+	if report := FormatTopReport(results); report != "" {
+		sb.WriteString(report + "\n")
+	}
+	for _, r := range results {
+		flags := ""
+		if r.HasPayPal {
+			flags += " [PayPal]"
+		}
+		if r.InstantDelivery {
+			flags += " [Instant]"
+		}
+		if r.Verified {
+			flags += " [Verified]"
+		}
+		sb.WriteString(fmt.Sprintf("%s | %s | trust=%.1f%s\n", r.Name, r.URL, r.TrustScore, flags))
+	}
+	return WriteFileAtomic(filename, []byte(sb.String()))
+}
+
+// SaveResultsCSV writes results with the given columns using encoding/csv.
+func SaveResultsCSV(results []Result, cols []string, filename string) error {
+	return WithAtomicFile(filename, func(f *os.File) error {
+		w := csv.NewWriter(f)
+		if err := w.Write(cols); err != nil {
+			return err
+		}
+		for _, r := range results {
+			record := make([]string, len(cols))
+			for i, col := range cols {
+				record[i] = ColumnValue(r, col)
+			}
+			if err := w.Write(record); err != nil {
+				return err
+			}
+		}
+		w.Flush()
+		return w.Error()
+	})
+}
+
+// SaveResultsMarkdown writes a GitHub-flavored Markdown table sorted by
+// trust score, ready for pasting into issues or wikis.
+func SaveResultsMarkdown(results []Result, filename string) error {
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("# CD-Key Stores (%d)\n\n", len(results)))
+	if top := TopStores(results, topReportSize); len(top) > 0 {
+		sb.WriteString(fmt.Sprintf("## Top %d by trust\n\n", len(top)))
+		for i, r := range top {
+			sb.WriteString(fmt.Sprintf("%d. [%s](%s) — trust %.1f",
+				i+1, escapeMarkdownCell(r.Name), r.URL, r.TrustScore))
+			if attrs := TopStoreAttrs(r); attrs != "" {
+				sb.WriteString(" (" + attrs + ")")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("| Store | URL | Source | Trust | Confidence | PayPal | Instant | Verified |\n")
+	sb.WriteString("|---|---|---|---:|---:|:-:|:-:|:-:|\n")
+	for _, r := range SortByTrustScore(results) {
+		sb.WriteString(fmt.Sprintf("| %s | %s | %s | %.1f | %.2f | %s | %s | %s |\n",
+			escapeMarkdownCell(r.Name), r.URL, r.Source,
+			r.TrustScore, r.Confidence,
+			markdownCheck(r.HasPayPal), markdownCheck(r.InstantDelivery), markdownCheck(r.Verified)))
+	}
+	return WriteFileAtomic(filename, []byte(sb.String()))
+}
+
+func markdownCheck(b bool) string {
+	if b {
+		return "✅"
+	}
+	return "—"
+}
+
+// escapeMarkdownCell keeps pipes and newlines in store names from breaking
+// the table layout.
+func escapeMarkdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	s = strings.ReplaceAll(s, "\n", " ")
+	return s
+}
@@ -0,0 +1,56 @@
+package export
+
+import (
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestResultsRoundTripCurrentSchema(t *testing.T) {
+	results := []Result{
+		{Name: "Key Palace", URL: "https://a.example", Source: "allkeyshop", TrustScore: 8.5, HasPayPal: true},
+		{Name: "GG Bargains", URL: "https://b.example", Source: "ggdeals", Confidence: 0.9, Verified: true},
+	}
+
+	path := filepath.Join(t.TempDir(), "results.json")
+	if err := SaveResultsJSON(results, path); err != nil {
+		t.Fatalf("SaveResultsJSON: %v", err)
+	}
+	loaded, err := LoadResultsJSON(path)
+	if err != nil {
+		t.Fatalf("LoadResultsJSON: %v", err)
+	}
+	if len(loaded) != len(results) {
+		t.Fatalf("got %d results back, want %d", len(loaded), len(results))
+	}
+	if !reflect.DeepEqual(loaded, results) {
+		t.Fatalf("round trip changed the results:\ngot  %+v\nwant %+v", loaded, results)
+	}
+}
+
+func TestDecodeResultsLiftsV1BareArray(t *testing.T) {
+	v1 := []byte(`[
+		{"name": "Old Export", "url": "https://old.example", "source": "imported"}
+	]`)
+	results, err := DecodeResults(v1)
+	if err != nil {
+		t.Fatalf("DecodeResults(v1): %v", err)
+	}
+	if len(results) != 1 || results[0].Name != "Old Export" {
+		t.Fatalf("DecodeResults(v1) = %+v, want the one old record", results)
+	}
+}
+
+func TestDecodeResultsRejectsNewerSchema(t *testing.T) {
+	future := []byte(`{"schema_version": 99, "saved_at": "2026-01-01T00:00:00Z", "results": []}`)
+	if _, err := DecodeResults(future); err == nil || !strings.Contains(err.Error(), "newer") {
+		t.Fatalf("DecodeResults(v99) = %v, want a newer-schema error", err)
+	}
+}
+
+func TestDecodeResultsRejectsForeignObjects(t *testing.T) {
+	if _, err := DecodeResults([]byte(`{"crowns": []}`)); err == nil {
+		t.Fatal("DecodeResults on a non-results object succeeded, want an error")
+	}
+}
@@ -0,0 +1,84 @@
+package export
+
+import (
+	"database/sql"
+	"time"
+
+	_ "modernc.org/sqlite"
+)
+
+const sqliteSchema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	started_at TEXT NOT NULL,
+	stores INTEGER NOT NULL
+);
+CREATE TABLE IF NOT EXISTS stores (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	run_id INTEGER NOT NULL REFERENCES runs(id),
+	name TEXT NOT NULL,
+	url TEXT NOT NULL,
+	source TEXT,
+	category TEXT,
+	trust_score REAL,
+	confidence REAL,
+	found_at TEXT
+);
+CREATE TABLE IF NOT EXISTS validations (
+	store_id INTEGER PRIMARY KEY REFERENCES stores(id),
+	verified INTEGER NOT NULL,
+	has_paypal INTEGER NOT NULL,
+	instant_delivery INTEGER NOT NULL
+);
+`
+
+// SaveResultsSQLite appends the results as a new run into a SQLite database,
+// creating the schema on first use.
+func SaveResultsSQLite(results []Result, filename string) error {
+	db, err := sql.Open("sqlite", filename)
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	if _, err := db.Exec(sqliteSchema); err != nil {
+		return err
+	}
+
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec("INSERT INTO runs (started_at, stores) VALUES (?, ?)",
+		time.Now().Format(time.RFC3339), len(results))
+	if err != nil {
+		return err
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return err
+	}
+
+	for _, r := range results {
+		res, err := tx.Exec(
+			`INSERT INTO stores (run_id, name, url, source, category, trust_score, confidence, found_at)
+			 VALUES (?, ?, ?, ?, ?, ?, ?, ?)`,
+			runID, r.Name, r.URL, r.Source, r.Category, r.TrustScore, r.Confidence,
+			r.FoundAt.Format(time.RFC3339))
+		if err != nil {
+			return err
+		}
+		storeID, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(
+			"INSERT INTO validations (store_id, verified, has_paypal, instant_delivery) VALUES (?, ?, ?, ?)",
+			storeID, r.Verified, r.HasPayPal, r.InstantDelivery); err != nil {
+			return err
+		}
+	}
+	return tx.Commit()
+}
@@ -0,0 +1,53 @@
+package export
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// templateContext is what a user-supplied export template renders against.
+type templateContext struct {
+	Results []Result
+	Stats   Statistics
+	Now     time.Time
+}
+
+var templateFuncs = template.FuncMap{
+	"sortByTrust": SortByTrustScore,
+	"paypal":      FilterByPayPal,
+	"verified":    FilterByVerified,
+	"minConfidence": func(min float64, results []Result) []Result {
+		return FilterByConfidence(results, min)
+	},
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// SaveResultsTemplate renders the results through a user-supplied
+// text/template file, so custom TXT/HTML/Markdown layouts need no code
+// changes. The output extension follows the template's (report.md.tmpl →
+// .md).
+func SaveResultsTemplate(results []Result, templatePath, outPath string) error {
+	tmpl, err := template.New(filepath.Base(templatePath)).
+		Funcs(templateFuncs).
+		ParseFiles(templatePath)
+	if err != nil {
+		return fmt.Errorf("parse template: %w", err)
+	}
+
+	ctx := templateContext{
+		Results: results,
+		Stats:   CalculateStatistics(results),
+		Now:     time.Now(),
+	}
+	return WithAtomicFile(outPath, func(f *os.File) error {
+		if err := tmpl.Execute(f, ctx); err != nil {
+			return fmt.Errorf("render template: %w", err)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,57 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+)
+
+// topReportSize is how many stores the top report ranks.
+const topReportSize = 10
+
+// TopStores returns the top n results by trust score.
+func TopStores(results []Result, n int) []Result {
+	sorted := SortByTrustScore(results)
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// TopStoreAttrs renders the key attributes shown next to a ranked store:
+// payment and delivery flags plus whatever the provider put in Extra
+// (platforms, regions, DRM services).
+func TopStoreAttrs(r Result) string {
+	var attrs []string
+	if r.HasPayPal {
+		attrs = append(attrs, "PayPal")
+	}
+	if r.InstantDelivery {
+		attrs = append(attrs, "Instant")
+	}
+	if r.Verified {
+		attrs = append(attrs, "Verified")
+	}
+	for k, v := range r.Extra {
+		attrs = append(attrs, k+"="+v)
+	}
+	return strings.Join(attrs, ", ")
+}
+
+// FormatTopReport renders the ranked top-store list as plain text; empty
+// input yields an empty report.
+func FormatTopReport(results []Result) string {
+	top := TopStores(results, topReportSize)
+	if len(top) == 0 {
+		return ""
+	}
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("🏆 Top %d stores by trust:\n", len(top)))
+	for i, r := range top {
+		line := fmt.Sprintf("%2d. %-30.30s trust=%.1f", i+1, r.Name, r.TrustScore)
+		if attrs := TopStoreAttrs(r); attrs != "" {
+			line += "  " + attrs
+		}
+		sb.WriteString(line + "\n")
+	}
+	return sb.String()
+}
@@ -0,0 +1,82 @@
+package export
+
+import (
+	"os"
+	"strconv"
+
+	"github.com/xuri/excelize/v2"
+)
+
+// SaveResultsXLSX writes a workbook with all stores on the main sheet plus
+// one sheet per source and per category, for triaging in a spreadsheet.
+func SaveResultsXLSX(results []Result, cols []string, filename string) error {
+	f := excelize.NewFile()
+	defer f.Close()
+
+	main := "Stores"
+	f.SetSheetName("Sheet1", main)
+	if err := writeStoreSheet(f, main, results, cols); err != nil {
+		return err
+	}
+
+	stats := CalculateStatistics(results)
+	for source := range stats.BySource {
+		sheet := sheetName("src " + source)
+		if _, err := f.NewSheet(sheet); err != nil {
+			return err
+		}
+		if err := writeStoreSheet(f, sheet, FilterBySource(results, source), cols); err != nil {
+			return err
+		}
+	}
+	for category := range stats.ByCategory {
+		sheet := sheetName("cat " + category)
+		if _, err := f.NewSheet(sheet); err != nil {
+			return err
+		}
+		var subset []Result
+		for _, r := range results {
+			if r.Category == category {
+				subset = append(subset, r)
+			}
+		}
+		if err := writeStoreSheet(f, sheet, subset, cols); err != nil {
+			return err
+		}
+	}
+
+	return WithAtomicFile(filename, func(tmp *os.File) error {
+		return f.Write(tmp)
+	})
+}
+
+// writeStoreSheet fills one sheet with a header row plus the results,
+// sorted by trust score.
+func writeStoreSheet(f *excelize.File, sheet string, results []Result, cols []string) error {
+	header := make([]interface{}, len(cols))
+	for i, col := range cols {
+		header[i] = col
+	}
+	if err := f.SetSheetRow(sheet, "A1", &header); err != nil {
+		return err
+	}
+	for i, r := range SortByTrustScore(results) {
+		row := make([]interface{}, len(cols))
+		for j, col := range cols {
+			row[j] = ColumnCell(r, col)
+		}
+		cell := "A" + strconv.Itoa(i+2)
+		if err := f.SetSheetRow(sheet, cell, &row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sheetName trims a label to Excel's 31-char sheet name limit.
+func sheetName(label string) string {
+	if len(label) > 31 {
+		return label[:31]
+	}
+	return label
+}
@@ -0,0 +1,239 @@
+// Package kvcache is a small bbolt-backed key/value cache with per-entry
+// TTLs and a per-bucket size cap. It replaces the in-memory maps the
+// fetcher, the validators and the dedup checks used to rebuild from
+// scratch every run: entries survive restarts and expire on their own.
+//
+// Every value is stored with its expiry prepended, so eviction needs no
+// index: Get drops expired entries lazily and Sweep clears the rest.
+package kvcache
+
+import (
+	"encoding/binary"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+// Options tune a Cache; zero values get the defaults noted on each field.
+type Options struct {
+	// DefaultTTL applies when Set is called with ttl 0. Default 24h.
+	DefaultTTL time.Duration
+	// MaxEntries caps each bucket; when a Set pushes a bucket over the
+	// cap, the entries closest to expiry are dropped. Default 10000.
+	MaxEntries int
+}
+
+// Cache is a TTL'd key/value store over one bbolt file. Buckets keep the
+// users apart ("http", "mx", "validate", ...). A nil *Cache is valid and
+// behaves as an always-empty cache, so callers need no guards when the
+// file could not be opened.
+type Cache struct {
+	db   *bolt.DB
+	opts Options
+}
+
+// Open opens (or creates) the cache file and sweeps expired entries.
+func Open(path string, opts Options) (*Cache, error) {
+	if opts.DefaultTTL <= 0 {
+		opts.DefaultTTL = 24 * time.Hour
+	}
+	if opts.MaxEntries <= 0 {
+		opts.MaxEntries = 10000
+	}
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, err
+	}
+	c := &Cache{db: db, opts: opts}
+	c.Sweep()
+	return c, nil
+}
+
+// Close flushes and closes the underlying file.
+func (c *Cache) Close() error {
+	if c == nil {
+		return nil
+	}
+	return c.db.Close()
+}
+
+// encode prepends the expiry (unix nanoseconds, big endian) to the value.
+func encode(value []byte, expires time.Time) []byte {
+	buf := make([]byte, 8+len(value))
+	binary.BigEndian.PutUint64(buf, uint64(expires.UnixNano()))
+	copy(buf[8:], value)
+	return buf
+}
+
+// decode splits an encoded entry; ok is false for corrupt entries.
+func decode(raw []byte) (value []byte, expires time.Time, ok bool) {
+	if len(raw) < 8 {
+		return nil, time.Time{}, false
+	}
+	return raw[8:], time.Unix(0, int64(binary.BigEndian.Uint64(raw))), true
+}
+
+// Get returns the cached value, or false when the key is missing or
+// expired. Expired entries are deleted on the spot.
+func (c *Cache) Get(bucket, key string) ([]byte, bool) {
+	if c == nil {
+		return nil, false
+	}
+	var value []byte
+	var expired bool
+	c.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket([]byte(bucket))
+		if b == nil {
+			return nil
+		}
+		raw := b.Get([]byte(key))
+		if raw == nil {
+			return nil
+		}
+		v, expires, ok := decode(raw)
+		if !ok || time.Now().After(expires) {
+			expired = true
+			return nil
+		}
+		value = append([]byte(nil), v...)
+		return nil
+	})
+	if expired {
+		c.db.Update(func(tx *bolt.Tx) error {
+			if b := tx.Bucket([]byte(bucket)); b != nil {
+				return b.Delete([]byte(key))
+			}
+			return nil
+		})
+	}
+	return value, value != nil
+}
+
+// Set stores the value under bucket/key; ttl 0 uses the default. When the
+// bucket is over its cap the entries closest to expiry make room.
+func (c *Cache) Set(bucket, key string, value []byte, ttl time.Duration) error {
+	if c == nil {
+		return nil
+	}
+	if ttl <= 0 {
+		ttl = c.opts.DefaultTTL
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(bucket))
+		if err != nil {
+			return err
+		}
+		if b.Stats().KeyN >= c.opts.MaxEntries {
+			trimBucket(b, c.opts.MaxEntries-c.opts.MaxEntries/10)
+		}
+		return b.Put([]byte(key), encode(value, time.Now().Add(ttl)))
+	})
+}
+
+// Seen reports whether the key was already marked in the bucket, marking
+// it either way. It is the cross-run replacement for seen-maps: the first
+// caller gets false, everyone after gets true until the entry expires.
+func (c *Cache) Seen(bucket, key string, ttl time.Duration) bool {
+	if c == nil {
+		return false
+	}
+	_, seen := c.Get(bucket, key)
+	c.Set(bucket, key, []byte{1}, ttl)
+	return seen
+}
+
+// trimBucket drops entries until the bucket holds at most keep, removing
+// the ones closest to expiry first (expired ones sort first by nature).
+func trimBucket(b *bolt.Bucket, keep int) {
+	type entry struct {
+		key     []byte
+		expires int64
+	}
+	var entries []entry
+	b.ForEach(func(k, v []byte) error {
+		e := entry{key: append([]byte(nil), k...)}
+		if len(v) >= 8 {
+			e.expires = int64(binary.BigEndian.Uint64(v))
+		}
+		entries = append(entries, e)
+		return nil
+	})
+	for len(entries) > keep {
+		oldest := 0
+		for i := range entries {
+			if entries[i].expires < entries[oldest].expires {
+				oldest = i
+			}
+		}
+		b.Delete(entries[oldest].key)
+		entries[oldest] = entries[len(entries)-1]
+		entries = entries[:len(entries)-1]
+	}
+}
+
+// Sweep deletes every expired entry and returns how many went.
+func (c *Cache) Sweep() int {
+	if c == nil {
+		return 0
+	}
+	removed := 0
+	now := time.Now()
+	c.db.Update(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			var stale [][]byte
+			b.ForEach(func(k, v []byte) error {
+				_, expires, ok := decode(v)
+				if !ok || now.After(expires) {
+					stale = append(stale, append([]byte(nil), k...))
+				}
+				return nil
+			})
+			for _, k := range stale {
+				if b.Delete(k) == nil {
+					removed++
+				}
+			}
+			return nil
+		})
+	})
+	return removed
+}
+
+// Stats returns the live entry count per bucket.
+func (c *Cache) Stats() map[string]int {
+	counts := make(map[string]int)
+	if c == nil {
+		return counts
+	}
+	c.db.View(func(tx *bolt.Tx) error {
+		return tx.ForEach(func(name []byte, b *bolt.Bucket) error {
+			counts[string(name)] = b.Stats().KeyN
+			return nil
+		})
+	})
+	return counts
+}
+
+// Clear drops the named buckets, or every bucket when none are given.
+func (c *Cache) Clear(buckets ...string) error {
+	if c == nil {
+		return nil
+	}
+	return c.db.Update(func(tx *bolt.Tx) error {
+		if len(buckets) == 0 {
+			tx.ForEach(func(name []byte, _ *bolt.Bucket) error {
+				buckets = append(buckets, string(name))
+				return nil
+			})
+		}
+		for _, name := range buckets {
+			if tx.Bucket([]byte(name)) == nil {
+				continue
+			}
+			if err := tx.DeleteBucket([]byte(name)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,75 @@
+package notify
+
+import (
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/smtp"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Mailer sends multipart report mail over SMTP.
+type Mailer struct {
+	Host       string
+	Port       int
+	Username   string
+	Password   string
+	From       string
+	Recipients []string
+}
+
+// Send emails the body under the subject, attaching the file when given.
+// An unreadable attachment is skipped with a warning so the report still
+// goes out.
+func (m Mailer) Send(subject, body, attachment string) error {
+	from := m.From
+	if from == "" {
+		from = m.Username
+	}
+
+	var msg strings.Builder
+	boundary := "crownpal-report-boundary"
+	msg.WriteString(fmt.Sprintf("From: %s\r\n", from))
+	msg.WriteString(fmt.Sprintf("To: %s\r\n", strings.Join(m.Recipients, ", ")))
+	msg.WriteString(fmt.Sprintf("Subject: %s\r\n", subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString(fmt.Sprintf("Content-Type: multipart/mixed; boundary=%s\r\n\r\n", boundary))
+
+	msg.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+	msg.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	msg.WriteString(body)
+	msg.WriteString("\r\n")
+
+	if attachment != "" {
+		data, err := os.ReadFile(attachment)
+		if err != nil {
+			fmt.Printf("⚠️  Cannot attach %s: %v\n", attachment, err)
+		} else {
+			name := filepath.Base(attachment)
+			ct := mime.TypeByExtension(filepath.Ext(name))
+			if ct == "" {
+				ct = "application/octet-stream"
+			}
+			msg.WriteString(fmt.Sprintf("--%s\r\n", boundary))
+			msg.WriteString(fmt.Sprintf("Content-Type: %s; name=%q\r\n", ct, name))
+			msg.WriteString(fmt.Sprintf("Content-Disposition: attachment; filename=%q\r\n", name))
+			msg.WriteString("Content-Transfer-Encoding: base64\r\n\r\n")
+			encoded := base64.StdEncoding.EncodeToString(data)
+			for len(encoded) > 76 {
+				msg.WriteString(encoded[:76] + "\r\n")
+				encoded = encoded[76:]
+			}
+			msg.WriteString(encoded + "\r\n")
+		}
+	}
+	msg.WriteString(fmt.Sprintf("--%s--\r\n", boundary))
+
+	addr := fmt.Sprintf("%s:%d", m.Host, m.Port)
+	var auth smtp.Auth
+	if m.Username != "" {
+		auth = smtp.PlainAuth("", m.Username, m.Password, m.Host)
+	}
+	return smtp.SendMail(addr, auth, from, m.Recipients, []byte(msg.String()))
+}
@@ -0,0 +1,224 @@
+// Package notify wraps the outbound channels the app reports through:
+// the Telegram Bot API and SMTP report mail. Routing, queueing policy and
+// configuration stay with the application; this package only speaks the
+// protocols.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log/slog"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultTelegramAPIBase is the production Bot API host.
+const DefaultTelegramAPIBase = "https://api.telegram.org"
+
+// Doer abstracts the HTTP client so tests can replace it alongside
+// APIBase without a real network.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Telegram sends messages and documents through the Bot API, retrying
+// rate-limit responses using the retry_after hint Telegram returns.
+type Telegram struct {
+	Token     string
+	ParseMode string
+	// APIBase overrides the Bot API host so tests can point sends at a
+	// fake server; empty means DefaultTelegramAPIBase.
+	APIBase string
+	Client  Doer
+	// Wait and Backoff plug the application's outbox pacing in; nil
+	// disables pacing.
+	Wait    func()
+	Backoff func(wait time.Duration)
+	// DryRun, when it returns true for a described send, skips it.
+	DryRun func(what string) bool
+	Logger *slog.Logger
+}
+
+func (t *Telegram) apiBase() string {
+	if t.APIBase != "" {
+		return t.APIBase
+	}
+	return DefaultTelegramAPIBase
+}
+
+func (t *Telegram) client() Doer {
+	if t.Client != nil {
+		return t.Client
+	}
+	return &http.Client{Timeout: 30 * time.Second}
+}
+
+func (t *Telegram) wait() {
+	if t.Wait != nil {
+		t.Wait()
+	}
+}
+
+func (t *Telegram) backoff(wait time.Duration) {
+	if t.Backoff != nil {
+		t.Backoff(wait)
+	}
+}
+
+func (t *Telegram) dryRun(what string) bool {
+	return t.DryRun != nil && t.DryRun(what)
+}
+
+func (t *Telegram) logger() *slog.Logger {
+	if t.Logger != nil {
+		return t.Logger
+	}
+	return slog.Default()
+}
+
+// SendMessage calls the Bot API sendMessage method. Cancelling ctx aborts
+// the send and any rate-limit retries.
+func (t *Telegram) SendMessage(ctx context.Context, chatID, text string) error {
+	if t.dryRun(fmt.Sprintf("Telegram sendMessage to %s (%d chars)", chatID, len(text))) {
+		return nil
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/sendMessage", t.apiBase(), t.Token)
+	form := url.Values{
+		"chat_id": {chatID},
+		"text":    {text},
+	}
+	if t.ParseMode != "" {
+		form.Set("parse_mode", t.ParseMode)
+	}
+	for attempt := 0; attempt < 3; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		t.wait()
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, strings.NewReader(form.Encode()))
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+		resp, err := t.client().Do(req)
+		if err != nil {
+			return err
+		}
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			t.logger().Debug("telegram message sent", "chat", chatID, "chars", len(text))
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests:
+			wait := retryAfter(body)
+			fmt.Printf("⚠️  Telegram rate limit, retrying in %s\n", wait)
+			t.logger().Warn("telegram rate limited", "chat", chatID, "retry_after", wait)
+			t.backoff(wait)
+		default:
+			t.logger().Error("telegram send failed", "chat", chatID, "status", resp.StatusCode)
+			return fmt.Errorf("status %d: %s", resp.StatusCode, ErrorDescription(body))
+		}
+	}
+	return fmt.Errorf("still rate limited after 3 attempts")
+}
+
+// SendDocument uploads a file via the Bot API sendDocument method, with
+// the same rate-limit retry as text sends.
+func (t *Telegram) SendDocument(ctx context.Context, chatID, path, caption string) error {
+	if t.dryRun(fmt.Sprintf("Telegram sendDocument %s to %s", path, chatID)) {
+		return nil
+	}
+	endpoint := fmt.Sprintf("%s/bot%s/sendDocument", t.apiBase(), t.Token)
+	for attempt := 0; attempt < 3; attempt++ {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		body, contentType, err := documentForm(chatID, path, caption)
+		if err != nil {
+			return err
+		}
+		t.wait()
+		req, err := http.NewRequestWithContext(ctx, "POST", endpoint, body)
+		if err != nil {
+			return err
+		}
+		req.Header.Set("Content-Type", contentType)
+		resp, err := t.client().Do(req)
+		if err != nil {
+			return err
+		}
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		resp.Body.Close()
+		switch {
+		case resp.StatusCode == http.StatusOK:
+			return nil
+		case resp.StatusCode == http.StatusTooManyRequests:
+			wait := retryAfter(respBody)
+			fmt.Printf("⚠️  Telegram rate limit, retrying in %s\n", wait)
+			t.backoff(wait)
+		default:
+			return fmt.Errorf("status %d: %s", resp.StatusCode, ErrorDescription(respBody))
+		}
+	}
+	return fmt.Errorf("still rate limited after 3 attempts")
+}
+
+// documentForm builds the multipart body for a sendDocument upload.
+func documentForm(chatID, path, caption string) (io.Reader, string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, "", err
+	}
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	w.WriteField("chat_id", chatID)
+	if caption != "" {
+		w.WriteField("caption", caption)
+	}
+	part, err := w.CreateFormFile("document", filepath.Base(path))
+	if err != nil {
+		return nil, "", err
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, "", err
+	}
+	if err := w.Close(); err != nil {
+		return nil, "", err
+	}
+	return &buf, w.FormDataContentType(), nil
+}
+
+// retryAfter extracts parameters.retry_after from a 429 response, falling
+// back to a short fixed wait.
+func retryAfter(body []byte) time.Duration {
+	var r struct {
+		Parameters struct {
+			RetryAfter int `json:"retry_after"`
+		} `json:"parameters"`
+	}
+	if json.Unmarshal(body, &r) == nil && r.Parameters.RetryAfter > 0 {
+		return time.Duration(r.Parameters.RetryAfter) * time.Second
+	}
+	return 3 * time.Second
+}
+
+// ErrorDescription extracts the human-readable error from a Bot API
+// response body.
+func ErrorDescription(body []byte) string {
+	var r struct {
+		Description string `json:"description"`
+	}
+	if json.Unmarshal(body, &r) == nil && r.Description != "" {
+		return r.Description
+	}
+	return "unknown error"
+}
@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const allKeyShopStoresURL = "https://www.allkeyshop.com/blog/stores/"
+
+// AllKeyShop merchant cards carry the store page link, display name and the
+// user rating (0-5 scale) in one block.
+var (
+	aksMerchantRe = regexp.MustCompile(
+		`(?s)<div[^>]*class="[^"]*merchant[^"]*"[^>]*>.*?<a[^>]+href="([^"]+)"[^>]*>\s*([^<]+?)\s*</a>.*?</div>`)
+	aksRatingRe = regexp.MustCompile(`(\d(?:[.,]\d{1,2})?)\s*/\s*5`)
+	aksNextRe   = regexp.MustCompile(`<a[^>]+class="[^"]*next[^"]*"[^>]+href="([^"]+)"`)
+)
+
+// aksRatingToTrust maps an AllKeyShop 0-5 user rating onto our 0-100 trust
+// scale. Unrated merchants get a neutral 40 so they sort below anything rated.
+func aksRatingToTrust(rating float64) float64 {
+	if rating <= 0 {
+		return 40
+	}
+	if rating > 5 {
+		rating = 5
+	}
+	return rating * 20
+}
+
+// scrapeAllKeyShop walks the AllKeyShop merchant directory page by page and
+// returns every listed store with its rating mapped into a trust score.
+func scrapeAllKeyShop(ctx context.Context, s *Scraper, stats *Stats) []Result {
+	var results []Result
+	seen := make(map[string]bool)
+	url := allKeyShopStoresURL
+
+	for page := 1; url != "" && page <= 20; page++ {
+		if ctx.Err() != nil {
+			break
+		}
+		stats.countQuery()
+
+		body, err := s.fetchPage(ctx, "allkeyshop", url)
+		if err != nil {
+			stats.RecordErrorAt(url, err)
+			if errors.Is(err, ErrRateLimited) {
+				// Back off once and retry the same page.
+				fmt.Printf("⏳ AllKeyShop rate limited, backing off...\n")
+				time.Sleep(10 * time.Second)
+				if body, err = s.fetchPage(ctx, "allkeyshop", url); err != nil {
+					stats.RecordErrorAt(url, err)
+					break
+				}
+			} else {
+				fmt.Printf("⚠️  AllKeyShop page %d: %v\n", page, err)
+				break
+			}
+		}
+
+		for _, m := range aksMerchantRe.FindAllStringSubmatch(body, -1) {
+			storeURL := strings.TrimSpace(m[1])
+			name := strings.TrimSpace(m[2])
+			if name == "" || storeURL == "" {
+				continue
+			}
+			if !strings.HasPrefix(storeURL, "http") {
+				storeURL = "https://www.allkeyshop.com" + storeURL
+			}
+			if seen[storeURL] {
+				stats.countDuplicate()
+				continue
+			}
+			seen[storeURL] = true
+
+			rating := aksParseRating(m[0])
+			results = append(results, Result{
+				Name:       name,
+				URL:        storeURL,
+				Source:     "allkeyshop",
+				Category:   "cdkey",
+				TrustScore: aksRatingToTrust(rating),
+				Confidence: 0.6,
+				FoundAt:    time.Now(),
+				Extra: map[string]string{
+					"rating": strconv.FormatFloat(rating, 'f', 2, 64),
+				},
+			})
+		}
+
+		url = ""
+		if next := aksNextRe.FindStringSubmatch(body); next != nil {
+			url = next[1]
+		}
+		time.Sleep(500 * time.Millisecond)
+	}
+
+	stats.addStores(len(results))
+	return results
+}
+
+// aksParseRating pulls the "x/5" rating out of one merchant block, 0 if absent.
+func aksParseRating(block string) float64 {
+	m := aksRatingRe.FindStringSubmatch(block)
+	if m == nil {
+		return 0
+	}
+	rating, err := strconv.ParseFloat(strings.ReplaceAll(m[1], ",", "."), 64)
+	if err != nil {
+		return 0
+	}
+	return rating
+}
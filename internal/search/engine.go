@@ -0,0 +1,129 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ScrapeAll runs every provider (built-in and registered) and returns the
+// merged, deduplicated results. It is the first half of Run, exported so
+// a coordinator can farm the validation half out to other machines.
+func ScrapeAll(ctx context.Context, scraper *Scraper, stats *Stats) []Result {
+	fmt.Println("🔍 Scraping providers...")
+	results := scrapePriceComparison(ctx, scraper, stats)
+	stats.RecordProvider("price-comparison", len(results), len(results))
+	stats.setStores(len(results))
+	providers := []Provider{
+		{Name: "allkeyshop", Scrape: scrapeAllKeyShop},
+		{Name: "ggdeals", Scrape: scrapeGGDeals},
+	}
+	providers = append(providers, registeredProviders()...)
+	for _, provider := range providers {
+		batch := provider.Scrape(ctx, scraper, stats)
+		before := len(results)
+		results = MergeResults(results, batch)
+		stats.RecordProvider(provider.Name, len(batch), len(results)-before)
+		stats.setStores(len(results))
+	}
+	return results
+}
+
+// StoreValidator checks one discovered store, filling its verification
+// fields. The validate package provides the default implementation; the
+// engine only sees the interface so the two packages stay cycle-free.
+type StoreValidator interface {
+	Validate(ctx context.Context, r *Result) error
+}
+
+// Run executes the scraping and validation pipeline and returns up to
+// cfg.TargetStores results. cfg.Timeout bounds every request and
+// cfg.RateLimitPerSec caps the request rate across all workers.
+// Cancelling ctx stops feeding work and makes
+// Run return whatever was collected. progress, when non-nil, is called
+// with every validated store so the caller can render live stats and
+// checkpoint partial results.
+func Run(ctx context.Context, cfg Config, stats *Stats, scraper *Scraper, validator StoreValidator, progress func(*Result)) []Result {
+	_, span := tracer().Start(ctx, "search",
+		trace.WithAttributes(attribute.Int("target", cfg.TargetStores)))
+	defer span.End()
+
+	// Every request in the run — scraping and validation alike — shares
+	// one rate limiter and the configured per-request timeout.
+	wait := newRateLimiter(cfg.RateLimitPerSec)
+	scraper.Wait = wait
+	if scraper.Timeout == 0 {
+		scraper.Timeout = cfg.Timeout
+	}
+
+	samplerDone := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(SampleInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				stats.Sample()
+			case <-samplerDone:
+				return
+			}
+		}
+	}()
+
+	results := ScrapeAll(ctx, scraper, stats)
+
+	fmt.Println("✅ Validating stores...")
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < cfg.MaxWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				wait(ctx)
+				if err := validateOne(ctx, cfg, validator, &results[i]); err != nil {
+					stats.RecordErrorAt(results[i].URL, err)
+				}
+				if progress != nil {
+					progress(&results[i])
+				}
+			}
+		}()
+	}
+feed:
+	for i := range results {
+		if i >= cfg.TargetStores {
+			break
+		}
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	close(samplerDone)
+	stats.Sample()
+
+	if len(results) > cfg.TargetStores {
+		results = results[:cfg.TargetStores]
+	}
+	return results
+}
+
+// validateOne applies the configured per-request timeout around a single
+// validation, whatever the validator implementation.
+func validateOne(ctx context.Context, cfg Config, validator StoreValidator, r *Result) error {
+	if cfg.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cfg.Timeout)
+		defer cancel()
+	}
+	return validator.Validate(ctx, r)
+}
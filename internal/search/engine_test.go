@@ -0,0 +1,118 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// validatorFunc adapts a function to StoreValidator for tests.
+type validatorFunc func(ctx context.Context, r *Result) error
+
+func (f validatorFunc) Validate(ctx context.Context, r *Result) error { return f(ctx, r) }
+
+func TestRateLimiterSpacesCalls(t *testing.T) {
+	wait := newRateLimiter(50) // one slot every 20ms
+	start := time.Now()
+	for i := 0; i < 4; i++ {
+		wait(context.Background())
+	}
+	// First call is immediate, the remaining three wait 20ms each.
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Fatalf("4 calls at 50/s took %s, want at least ~60ms", elapsed)
+	}
+}
+
+func TestRateLimiterDisabled(t *testing.T) {
+	wait := newRateLimiter(0)
+	start := time.Now()
+	for i := 0; i < 1000; i++ {
+		wait(context.Background())
+	}
+	if elapsed := time.Since(start); elapsed > 100*time.Millisecond {
+		t.Fatalf("disabled limiter delayed 1000 calls by %s", elapsed)
+	}
+}
+
+func TestRateLimiterRespectsCancel(t *testing.T) {
+	wait := newRateLimiter(1) // one slot per second
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	start := time.Now()
+	wait(ctx) // takes the immediate slot
+	wait(ctx) // would sleep a second, but the context is done
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Fatalf("cancelled wait still blocked for %s", elapsed)
+	}
+}
+
+func TestFetchPageHonorsTimeout(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	defer server.Close()
+	// Unblock the handler before Close waits for it.
+	defer close(release)
+
+	s := NewScraper(server.Client(), nil, nil, Env{})
+	s.Timeout = 30 * time.Millisecond
+	start := time.Now()
+	_, err := s.fetchPage(context.Background(), "test", server.URL)
+	if err == nil {
+		t.Fatal("expected the fetch to time out")
+	}
+	if !errors.Is(err, ErrTimeout) {
+		t.Fatalf("err = %v, want class %v", err, ErrTimeout)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("fetch took %s despite a 30ms timeout", elapsed)
+	}
+}
+
+func TestRunAppliesConfigToScraperAndValidator(t *testing.T) {
+	RegisterProvider(Provider{
+		Name: "engine-test",
+		Scrape: func(ctx context.Context, s *Scraper, stats *Stats) []Result {
+			return []Result{
+				{Name: "Alpha", URL: "https://alpha.example"},
+				{Name: "Beta", URL: "https://beta.example"},
+			}
+		},
+	})
+	scraper := NewScraper(doerFunc(func(*http.Request) (*http.Response, error) {
+		return nil, errors.New("offline")
+	}), nil, nil, Env{})
+
+	cfg := Config{
+		MaxWorkers:      1,
+		Timeout:         250 * time.Millisecond,
+		RateLimitPerSec: 1000,
+		TargetStores:    2,
+	}
+	validated := 0
+	results := Run(context.Background(), cfg, NewStats(), scraper,
+		validatorFunc(func(ctx context.Context, r *Result) error {
+			validated++
+			if _, ok := ctx.Deadline(); !ok {
+				t.Errorf("validator for %s got no deadline despite cfg.Timeout", r.URL)
+			}
+			return nil
+		}), nil)
+
+	if validated == 0 {
+		t.Fatal("validator never ran")
+	}
+	if len(results) < 2 {
+		t.Fatalf("got %d results, want the 2 the test provider returned", len(results))
+	}
+	if scraper.Wait == nil {
+		t.Error("Run did not install the rate limiter on the scraper")
+	}
+	if scraper.Timeout != cfg.Timeout {
+		t.Errorf("scraper.Timeout = %s, want %s", scraper.Timeout, cfg.Timeout)
+	}
+}
@@ -0,0 +1,75 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+)
+
+// Sentinel error classes for scraper failures, so the engine can decide
+// between backing off, skipping, or switching provider.
+var (
+	ErrRateLimited = errors.New("rate limited")
+	ErrBlocked     = errors.New("blocked")
+	ErrTimeout     = errors.New("timeout")
+	ErrParse       = errors.New("parse error")
+)
+
+// ScrapeError wraps a failure with its class and the provider it came from.
+type ScrapeError struct {
+	Provider string
+	Class    error // one of the sentinels above, or nil for unclassified
+	Err      error
+}
+
+func (e *ScrapeError) Error() string {
+	if e.Class != nil {
+		return fmt.Sprintf("%s: %s: %v", e.Provider, e.Class, e.Err)
+	}
+	return fmt.Sprintf("%s: %v", e.Provider, e.Err)
+}
+
+func (e *ScrapeError) Unwrap() error {
+	if e.Class != nil {
+		return e.Class
+	}
+	return e.Err
+}
+
+// NewScrapeError classifies err (and, when available, the HTTP status) into
+// one of the sentinel classes.
+func NewScrapeError(provider string, status int, err error) *ScrapeError {
+	se := &ScrapeError{Provider: provider, Err: err}
+	switch {
+	case status == http.StatusTooManyRequests:
+		se.Class = ErrRateLimited
+	case status == http.StatusForbidden || status == http.StatusUnavailableForLegalReasons:
+		se.Class = ErrBlocked
+	case isTimeout(err):
+		se.Class = ErrTimeout
+	}
+	return se
+}
+
+func isTimeout(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// ErrorClass reports the class label used in reports ("unclassified" when
+// the error carries none of the sentinels).
+func ErrorClass(err error) string {
+	switch {
+	case errors.Is(err, ErrRateLimited):
+		return "rate_limited"
+	case errors.Is(err, ErrBlocked):
+		return "blocked"
+	case errors.Is(err, ErrTimeout):
+		return "timeout"
+	case errors.Is(err, ErrParse):
+		return "parse"
+	default:
+		return "unclassified"
+	}
+}
@@ -0,0 +1,77 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+const ggDealsShopsURL = "https://gg.deals/shops/"
+
+// gg.deals renders each shop as a card with the shop link/name plus badge
+// spans for voucher support and the DRM services the shop sells keys for.
+var (
+	ggShopRe = regexp.MustCompile(
+		`(?s)<div[^>]*class="[^"]*shop-card[^"]*"[^>]*>.*?<a[^>]+href="([^"]+)"[^>]*>\s*([^<]+?)\s*</a>(.*?)</div>`)
+	ggVoucherRe = regexp.MustCompile(`(?i)class="[^"]*voucher[^"]*"`)
+	ggDrmRe     = regexp.MustCompile(`(?i)<span[^>]*class="[^"]*drm[^"]*"[^>]*>\s*([^<]+?)\s*</span>`)
+)
+
+// scrapeGGDeals parses the gg.deals shops index and captures voucher support
+// and DRM info into the result's extra fields.
+func scrapeGGDeals(ctx context.Context, s *Scraper, stats *Stats) []Result {
+	stats.countQuery()
+
+	body, err := s.fetchPage(ctx, "ggdeals", ggDealsShopsURL)
+	if err != nil {
+		stats.RecordErrorAt(ggDealsShopsURL, err)
+		fmt.Printf("⚠️  gg.deals shops index: %v\n", err)
+		return nil
+	}
+
+	var results []Result
+	seen := make(map[string]bool)
+	for _, m := range ggShopRe.FindAllStringSubmatch(body, -1) {
+		shopURL := strings.TrimSpace(m[1])
+		name := strings.TrimSpace(m[2])
+		card := m[3]
+		if name == "" || shopURL == "" {
+			continue
+		}
+		if !strings.HasPrefix(shopURL, "http") {
+			shopURL = "https://gg.deals" + shopURL
+		}
+		if seen[shopURL] {
+			stats.countDuplicate()
+			continue
+		}
+		seen[shopURL] = true
+
+		extra := map[string]string{
+			"vouchers": fmt.Sprintf("%t", ggVoucherRe.MatchString(card)),
+		}
+		var drms []string
+		for _, d := range ggDrmRe.FindAllStringSubmatch(card, -1) {
+			drms = append(drms, strings.TrimSpace(d[1]))
+		}
+		if len(drms) > 0 {
+			extra["drm"] = strings.Join(drms, ",")
+		}
+
+		results = append(results, Result{
+			Name:       name,
+			URL:        shopURL,
+			Source:     "ggdeals",
+			Category:   "cdkey",
+			TrustScore: 50,
+			Confidence: 0.6,
+			FoundAt:    time.Now(),
+			Extra:      extra,
+		})
+	}
+
+	stats.addStores(len(results))
+	return results
+}
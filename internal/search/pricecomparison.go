@@ -0,0 +1,48 @@
+package search
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var storeLinkRe = regexp.MustCompile(`<a[^>]+href="(https?://[^"]+)"[^>]*>([^<]{3,40})</a>`)
+
+// scrapePriceComparison greps price-comparison homepages for outbound store links.
+func scrapePriceComparison(ctx context.Context, s *Scraper, stats *Stats) []Result {
+	pages := []string{
+		"https://www.allkeyshop.com/blog/",
+		"https://gg.deals/",
+	}
+	var results []Result
+	seen := make(map[string]bool)
+	for _, page := range pages {
+		if ctx.Err() != nil {
+			break
+		}
+		stats.countQuery()
+		body, err := s.fetchPage(ctx, "price-comparison", page)
+		if err != nil {
+			stats.RecordErrorAt(page, err)
+			continue
+		}
+		for _, m := range storeLinkRe.FindAllStringSubmatch(body, -1) {
+			url, name := m[1], strings.TrimSpace(m[2])
+			if name == "" || seen[url] {
+				continue
+			}
+			seen[url] = true
+			results = append(results, Result{
+				Name:       name,
+				URL:        url,
+				Source:     "price-comparison",
+				Category:   "cdkey",
+				TrustScore: 50,
+				Confidence: 0.3,
+				FoundAt:    time.Now(),
+			})
+		}
+	}
+	return results
+}
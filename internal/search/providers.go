@@ -0,0 +1,34 @@
+package search
+
+import (
+	"context"
+	"sync"
+)
+
+// Provider is an additional scrape source the embedding application
+// registers at startup (external plugins, experiments). The built-in
+// providers don't go through the registry.
+type Provider struct {
+	Name   string
+	Scrape func(ctx context.Context, s *Scraper, stats *Stats) []Result
+}
+
+var (
+	extraMu        sync.Mutex
+	extraProviders []Provider
+)
+
+// RegisterProvider makes every subsequent Run include the provider after
+// the built-in ones.
+func RegisterProvider(p Provider) {
+	extraMu.Lock()
+	defer extraMu.Unlock()
+	extraProviders = append(extraProviders, p)
+}
+
+// registeredProviders copies the registry for a run.
+func registeredProviders() []Provider {
+	extraMu.Lock()
+	defer extraMu.Unlock()
+	return append([]Provider(nil), extraProviders...)
+}
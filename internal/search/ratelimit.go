@@ -0,0 +1,39 @@
+package search
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// newRateLimiter returns a wait function that spaces calls to at most
+// perSec per second, shared across goroutines; perSec <= 0 disables the
+// limit. There is no background goroutine: callers reserve the next slot
+// under a mutex and sleep until it arrives (or their context ends).
+func newRateLimiter(perSec int) func(context.Context) {
+	if perSec <= 0 {
+		return func(context.Context) {}
+	}
+	interval := time.Second / time.Duration(perSec)
+	var mu sync.Mutex
+	var next time.Time
+	return func(ctx context.Context) {
+		mu.Lock()
+		now := time.Now()
+		if next.Before(now) {
+			next = now
+		}
+		wait := next.Sub(now)
+		next = next.Add(interval)
+		mu.Unlock()
+		if wait <= 0 {
+			return
+		}
+		timer := time.NewTimer(wait)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+		}
+	}
+}
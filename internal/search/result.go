@@ -0,0 +1,45 @@
+// Package search implements the CD-key store discovery engine: the
+// scraper, the per-provider parsers and the run pipeline. It reports
+// into the embedding application only through the hooks in Env, so it
+// can be driven by the CLI, the finder library or tests alike.
+package search
+
+import "time"
+
+// Result is a single discovered CD-key store.
+type Result struct {
+	Name            string            `json:"name"`
+	URL             string            `json:"url"`
+	Source          string            `json:"source"`
+	Category        string            `json:"category"`
+	TrustScore      float64           `json:"trust_score"`
+	Confidence      float64           `json:"confidence"`
+	HasPayPal       bool              `json:"has_paypal"`
+	InstantDelivery bool              `json:"instant_delivery"`
+	Verified        bool              `json:"verified"`
+	FoundAt         time.Time         `json:"found_at"`
+	Extra           map[string]string `json:"extra,omitempty"`
+}
+
+// Config controls a finder run.
+type Config struct {
+	MaxWorkers      int
+	Timeout         time.Duration
+	RateLimitPerSec int
+	TargetStores    int
+}
+
+// MergeResults appends extra results, skipping URLs already present.
+func MergeResults(base, extra []Result) []Result {
+	seen := make(map[string]bool, len(base))
+	for _, r := range base {
+		seen[r.URL] = true
+	}
+	for _, r := range extra {
+		if !seen[r.URL] {
+			seen[r.URL] = true
+			base = append(base, r)
+		}
+	}
+	return base
+}
@@ -0,0 +1,178 @@
+package search
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Env carries the hooks the embedding application uses to observe and
+// steer the engine; the zero value disables them all.
+type Env struct {
+	// DryRun, when it returns true for a described action, makes the
+	// engine skip the network work (the application prints the skip).
+	DryRun func(what string) bool
+	// OnRequest is called once per HTTP request with its outcome; status 0
+	// means the request failed before a response arrived.
+	OnRequest func(provider string, status int, bytes int64, elapsed time.Duration)
+	// Verbosef receives per-request trace lines for verbose terminals.
+	Verbosef func(format string, args ...interface{})
+	Logger   *slog.Logger
+}
+
+// normalized fills nil hooks with no-ops so call sites stay unguarded.
+func (e Env) normalized() Env {
+	if e.DryRun == nil {
+		e.DryRun = func(string) bool { return false }
+	}
+	if e.OnRequest == nil {
+		e.OnRequest = func(string, int, int64, time.Duration) {}
+	}
+	if e.Verbosef == nil {
+		e.Verbosef = func(string, ...interface{}) {}
+	}
+	if e.Logger == nil {
+		e.Logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+	}
+	return e
+}
+
+// tracer returns the process tracer; a no-op one until the application
+// wires a provider into the otel globals.
+func tracer() trace.Tracer {
+	return otel.Tracer("crownpal")
+}
+
+// Doer is the one method of *http.Client the engine needs; tests inject
+// an httptest-backed or fixture-replaying implementation instead of
+// hitting the network.
+type Doer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// ByteCache is the engine's view of the application's persistent cache.
+// The application picks storage and TTLs; the engine only asks for page
+// bodies by URL. A nil Cache disables caching.
+type ByteCache interface {
+	Get(bucket, key string) ([]byte, bool)
+	Set(bucket, key string, value []byte)
+}
+
+// Scraper fetches and parses provider pages.
+type Scraper struct {
+	client         Doer
+	Headers        map[string]string
+	HeaderProfiles map[string]map[string]string
+	UserAgents     []string
+	// Cache, when set, serves repeat page fetches from the "http" bucket
+	// instead of the network.
+	Cache ByteCache
+	// Timeout bounds each fetch; 0 leaves only the client's own timeout.
+	// Run fills it from Config.Timeout when the caller left it zero.
+	Timeout time.Duration
+	// Wait, when set, blocks until the rate limiter grants a request
+	// slot. Run installs the limiter built from Config.RateLimitPerSec.
+	Wait func(ctx context.Context)
+	env  Env
+}
+
+// NewScraper builds a scraper. A nil client gets a default with a 15s
+// timeout; headers and per-provider header profiles may be nil.
+func NewScraper(client Doer, headers map[string]string, profiles map[string]map[string]string, env Env) *Scraper {
+	if client == nil {
+		client = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &Scraper{
+		client:         client,
+		Headers:        headers,
+		HeaderProfiles: profiles,
+		UserAgents: []string{
+			"Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/120.0.0.0 Safari/537.36",
+			"Mozilla/5.0 (Macintosh; Intel Mac OS X 10_15_7) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/119.0.0.0 Safari/537.36",
+			"Mozilla/5.0 (X11; Linux x86_64; rv:121.0) Gecko/20100101 Firefox/121.0",
+		},
+		env: env.normalized(),
+	}
+}
+
+// headersFor merges the provider's header profile over the base headers.
+func (s *Scraper) headersFor(provider string) map[string]string {
+	profile := s.HeaderProfiles[provider]
+	if len(profile) == 0 {
+		return s.Headers
+	}
+	merged := make(map[string]string, len(s.Headers)+len(profile))
+	for k, v := range s.Headers {
+		merged[k] = v
+	}
+	for k, v := range profile {
+		merged[k] = v
+	}
+	return merged
+}
+
+// fetchPage downloads a URL on behalf of a provider and returns the body.
+func (s *Scraper) fetchPage(ctx context.Context, provider, url string) (string, error) {
+	if s.env.DryRun(fmt.Sprintf("GET %s (%s)", url, provider)) {
+		return "", nil
+	}
+	if s.Cache != nil {
+		if body, ok := s.Cache.Get("http", url); ok {
+			s.env.Verbosef("🔍 GET %s → cached (%d bytes)\n", url, len(body))
+			return string(body), nil
+		}
+	}
+	if s.Wait != nil {
+		s.Wait(ctx)
+	}
+	if s.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.Timeout)
+		defer cancel()
+	}
+	_, span := tracer().Start(ctx, "fetch", trace.WithAttributes(
+		attribute.String("provider", provider), attribute.String("url", url)))
+	defer span.End()
+	req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("User-Agent", s.UserAgents[rand.Intn(len(s.UserAgents))])
+	for k, v := range s.headersFor(provider) {
+		req.Header.Set(k, v)
+	}
+	start := time.Now()
+	resp, err := s.client.Do(req)
+	if err != nil {
+		s.env.OnRequest(provider, 0, 0, time.Since(start))
+		s.env.Logger.Warn("fetch failed", "provider", provider, "url", url, "err", err)
+		span.RecordError(err)
+		return "", NewScrapeError(provider, 0, err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	s.env.OnRequest(provider, resp.StatusCode, int64(len(body)), time.Since(start))
+	s.env.Verbosef("🔍 GET %s → %d (%d bytes, %s)\n",
+		url, resp.StatusCode, len(body), time.Since(start).Round(time.Millisecond))
+	s.env.Logger.Debug("fetch", "provider", provider, "url", url,
+		"status", resp.StatusCode, "bytes", len(body), "elapsed", time.Since(start))
+	if resp.StatusCode != http.StatusOK {
+		return "", NewScrapeError(provider, resp.StatusCode,
+			fmt.Errorf("status %d for %s", resp.StatusCode, url))
+	}
+	if err != nil {
+		return "", NewScrapeError(provider, resp.StatusCode, err)
+	}
+	if s.Cache != nil {
+		s.Cache.Set("http", url, body)
+	}
+	return string(body), nil
+}
@@ -0,0 +1,79 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// TestFetchPageInjectedClient exercises a fetch end to end against an
+// httptest server through the injected Doer — no real network.
+func TestFetchPageInjectedClient(t *testing.T) {
+	var gotReferer string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotReferer = r.Header.Get("Referer")
+		w.Write([]byte("<html>stores</html>"))
+	}))
+	defer srv.Close()
+
+	s := NewScraper(srv.Client(),
+		map[string]string{"Referer": "https://base.example/"},
+		map[string]map[string]string{"ggdeals": {"Referer": "https://gg.example/"}},
+		Env{})
+
+	body, err := s.fetchPage(context.Background(), "ggdeals", srv.URL)
+	if err != nil {
+		t.Fatalf("fetchPage: %v", err)
+	}
+	if body != "<html>stores</html>" {
+		t.Errorf("body = %q", body)
+	}
+	if gotReferer != "https://gg.example/" {
+		t.Errorf("Referer = %q, want the ggdeals profile override", gotReferer)
+	}
+}
+
+// TestFetchPageClassifiesStatus checks that HTTP failures come back as
+// classified ScrapeErrors.
+func TestFetchPageClassifiesStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	s := NewScraper(srv.Client(), nil, nil, Env{})
+	_, err := s.fetchPage(context.Background(), "allkeyshop", srv.URL)
+	if !errors.Is(err, ErrRateLimited) {
+		t.Fatalf("err = %v, want ErrRateLimited", err)
+	}
+	if ErrorClass(err) != "rate_limited" {
+		t.Errorf("class = %q", ErrorClass(err))
+	}
+}
+
+// doerFunc adapts a function to the Doer interface for fixture replay.
+type doerFunc func(req *http.Request) (*http.Response, error)
+
+func (f doerFunc) Do(req *http.Request) (*http.Response, error) { return f(req) }
+
+// TestFetchPageReportsTransportErrors checks that a failing Doer surfaces
+// through OnRequest with status 0.
+func TestFetchPageReportsTransportErrors(t *testing.T) {
+	var reported int
+	env := Env{OnRequest: func(provider string, status int, bytes int64, _ time.Duration) {
+		reported = status + 1 // distinguish "called with 0" from "not called"
+	}}
+	s := NewScraper(doerFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}), nil, nil, env)
+
+	if _, err := s.fetchPage(context.Background(), "ggdeals", "http://unused.example/"); err == nil {
+		t.Fatal("expected an error from the failing Doer")
+	}
+	if reported != 1 {
+		t.Errorf("OnRequest not called with status 0 (got %d)", reported-1)
+	}
+}
@@ -0,0 +1,233 @@
+package search
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Stats is updated live while a search runs. The exported fields may be
+// read without the lock once the run has finished; concurrent readers go
+// through Snapshot.
+type Stats struct {
+	mu            sync.Mutex
+	StoresFound   int
+	Queries       int
+	Duplicates    int
+	Errors        int
+	ErrorsByClass map[string]int
+	StartTime     time.Time
+	// StoresSeries and QueriesSeries are sampled every SampleInterval
+	// while the run is active, for sparkline trends and run records.
+	StoresSeries  []int
+	QueriesSeries []int
+	// ByProvider tallies per-provider effectiveness for the league table.
+	ByProvider map[string]*ProviderStats
+	// ErrorLog collects every failure with its context for the end-of-run
+	// error report.
+	ErrorLog []ErrorEntry
+}
+
+// ErrorEntry is one collected failure.
+type ErrorEntry struct {
+	Provider string `json:"provider"`
+	URL      string `json:"url,omitempty"`
+	Class    string `json:"class"`
+	Message  string `json:"message"`
+}
+
+// ProviderStats is one provider's yield in a run.
+type ProviderStats struct {
+	Found  int // results the provider returned
+	Unique int // of those, new after dedupe against earlier providers
+	Errors int
+}
+
+// Snapshot is a consistent copy of the live counters for displays that
+// render outside the stats lock.
+type Snapshot struct {
+	Stores        int
+	Queries       int
+	Duplicates    int
+	Errors        int
+	StartTime     time.Time
+	ErrorsByClass map[string]int
+	ByProvider    map[string]ProviderStats
+	ErrorLog      []ErrorEntry
+}
+
+// NewStats returns run stats with the clock started.
+func NewStats() *Stats {
+	return &Stats{StartTime: time.Now()}
+}
+
+// RecordProvider tallies one provider's batch after merging.
+func (st *Stats) RecordProvider(provider string, found, unique int) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	p := st.provider(provider)
+	p.Found += found
+	p.Unique += unique
+	st.Duplicates += found - unique
+}
+
+// provider returns the (lazily created) bucket; callers hold st.mu.
+func (st *Stats) provider(name string) *ProviderStats {
+	if st.ByProvider == nil {
+		st.ByProvider = make(map[string]*ProviderStats)
+	}
+	p := st.ByProvider[name]
+	if p == nil {
+		p = &ProviderStats{}
+		st.ByProvider[name] = p
+	}
+	return p
+}
+
+// SampleInterval is how often the time series is sampled.
+const SampleInterval = 2 * time.Second
+
+// Sample appends the current counters to the time series.
+func (st *Stats) Sample() {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.StoresSeries = append(st.StoresSeries, st.StoresFound)
+	st.QueriesSeries = append(st.QueriesSeries, st.Queries)
+}
+
+// Series converts the sampled counters into the run-record layout:
+// cumulative stores plus queries-per-second derived from sample deltas.
+func (st *Stats) Series() map[string][]int {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if len(st.StoresSeries) == 0 {
+		return nil
+	}
+	qps := make([]int, len(st.QueriesSeries))
+	prev := 0
+	for i, q := range st.QueriesSeries {
+		qps[i] = (q - prev) / int(SampleInterval/time.Second)
+		prev = q
+	}
+	return map[string][]int{
+		"stores": append([]int(nil), st.StoresSeries...),
+		"qps":    qps,
+	}
+}
+
+// RecordError counts an error into the totals and its class bucket.
+func (st *Stats) RecordError(err error) {
+	st.RecordErrorAt("", err)
+}
+
+// RecordErrorAt is RecordError with the URL the failure happened on, when
+// the caller knows it.
+func (st *Stats) RecordErrorAt(url string, err error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	st.Errors++
+	if st.ErrorsByClass == nil {
+		st.ErrorsByClass = make(map[string]int)
+	}
+	st.ErrorsByClass[ErrorClass(err)]++
+	provider := "validator"
+	var se *ScrapeError
+	if errors.As(err, &se) {
+		provider = se.Provider
+		st.provider(se.Provider).Errors++
+	}
+	st.ErrorLog = append(st.ErrorLog, ErrorEntry{
+		Provider: provider,
+		URL:      url,
+		Class:    ErrorClass(err),
+		Message:  err.Error(),
+	})
+}
+
+// countQuery bumps the query counter; providers call it per page fetched.
+func (st *Stats) countQuery() {
+	st.mu.Lock()
+	st.Queries++
+	st.mu.Unlock()
+}
+
+// countDuplicate bumps the duplicate counter for an in-provider repeat.
+func (st *Stats) countDuplicate() {
+	st.mu.Lock()
+	st.Duplicates++
+	st.mu.Unlock()
+}
+
+// addStores bumps the found counter by a provider's batch size.
+func (st *Stats) addStores(n int) {
+	st.mu.Lock()
+	st.StoresFound += n
+	st.mu.Unlock()
+}
+
+// setStores sets the found counter to the merged total.
+func (st *Stats) setStores(n int) {
+	st.mu.Lock()
+	st.StoresFound = n
+	st.mu.Unlock()
+}
+
+// Snapshot returns a deep copy of the counters for lock-free rendering.
+func (st *Stats) Snapshot() Snapshot {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	snap := Snapshot{
+		Stores:     st.StoresFound,
+		Queries:    st.Queries,
+		Duplicates: st.Duplicates,
+		Errors:     st.Errors,
+		StartTime:  st.StartTime,
+		ErrorLog:   append([]ErrorEntry(nil), st.ErrorLog...),
+	}
+	if len(st.ErrorsByClass) > 0 {
+		snap.ErrorsByClass = make(map[string]int, len(st.ErrorsByClass))
+		for class, n := range st.ErrorsByClass {
+			snap.ErrorsByClass[class] = n
+		}
+	}
+	if len(st.ByProvider) > 0 {
+		snap.ByProvider = make(map[string]ProviderStats, len(st.ByProvider))
+		for name, p := range st.ByProvider {
+			snap.ByProvider[name] = *p
+		}
+	}
+	return snap
+}
+
+// LeagueTable renders which providers actually produce unique stores, so
+// ones that never yield anything can be pruned. Empty when no provider
+// ran.
+func (st *Stats) LeagueTable() string {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+	if len(st.ByProvider) == 0 {
+		return ""
+	}
+	names := make([]string, 0, len(st.ByProvider))
+	for name := range st.ByProvider {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return st.ByProvider[names[i]].Unique > st.ByProvider[names[j]].Unique
+	})
+	var sb strings.Builder
+	sb.WriteString("📊 Provider effectiveness:\n")
+	sb.WriteString(fmt.Sprintf("   %-18s %6s %7s %6s %7s\n", "provider", "found", "unique", "dup%", "errors"))
+	for _, name := range names {
+		p := st.ByProvider[name]
+		dupRate := 0.0
+		if p.Found > 0 {
+			dupRate = float64(p.Found-p.Unique) / float64(p.Found) * 100
+		}
+		sb.WriteString(fmt.Sprintf("   %-18s %6d %7d %5.1f%% %7d\n", name, p.Found, p.Unique, dupRate, p.Errors))
+	}
+	return sb.String()
+}
@@ -0,0 +1,82 @@
+// Package stock holds the crown-stock domain types: the Crown record,
+// the Store interface every stock backend implements, and the per-type
+// code validation rules. The in-memory backend and the menus stay with
+// the application.
+package stock
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// nearExpiryWindow is how far ahead of expiry a crown counts as "near".
+const nearExpiryWindow = 7 * 24 * time.Hour
+
+// Crown is a single stocked code.
+type Crown struct {
+	Code      string     `json:"code"`
+	Type      string     `json:"type"`
+	Pool      string     `json:"pool,omitempty"`
+	Value     float64    `json:"value"`
+	AddedAt   time.Time  `json:"added_at"`
+	ExpiresAt *time.Time `json:"expires_at,omitempty"`
+	Tags      []string   `json:"tags,omitempty"`
+	Note      string     `json:"note,omitempty"`
+	Used      bool       `json:"used"`
+	UsedAt    *time.Time `json:"used_at,omitempty"`
+	UsedNote  string     `json:"used_note,omitempty"`
+}
+
+// HasTag reports whether the crown carries the tag (case-insensitive).
+func (c Crown) HasTag(tag string) bool {
+	for _, t := range c.Tags {
+		if strings.EqualFold(t, tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Expired reports whether the crown is past its expiry date.
+func (c Crown) Expired() bool {
+	return c.ExpiresAt != nil && time.Now().After(*c.ExpiresAt)
+}
+
+// NearExpiry reports whether the crown expires within the warning window.
+func (c Crown) NearExpiry() bool {
+	return c.ExpiresAt != nil && !c.Expired() &&
+		time.Until(*c.ExpiresAt) <= nearExpiryWindow
+}
+
+// ErrPoolEmpty is returned when a pool has no unused crowns left.
+var ErrPoolEmpty = errors.New("no unused crowns in pool")
+
+// ErrAlreadyUsed is returned when the crown was already dispensed.
+var ErrAlreadyUsed = errors.New("crown already dispensed")
+
+// ErrNotInStock is returned when no stocked crown carries the code.
+var ErrNotInStock = errors.New("code not in stock")
+
+// Store is the single code path for stock mutations, shared by the menu,
+// auto restock, the Telegram bot and any future API.
+type Store interface {
+	// Add merges crowns, skipping codes already present; returns how many
+	// were added and how many were skipped as duplicates.
+	Add(crowns ...Crown) (added, skipped int)
+	// List returns a snapshot of the stock.
+	List() []Crown
+	// Remove deletes the crown with the given code.
+	Remove(code string) bool
+	// Dispense atomically marks and returns the oldest unused crown in the
+	// pool ("" is the default pool).
+	Dispense(pool string) (Crown, error)
+	// MarkUsed marks the crown with the given code dispensed, recording
+	// the time and an optional note.
+	MarkUsed(code, note string) (Crown, error)
+	// Replace swaps the entire stock, for undo, snapshot recovery and
+	// workspace switches.
+	Replace(crowns []Crown)
+	// Count reports how many crowns are stocked.
+	Count() int
+}
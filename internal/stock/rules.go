@@ -0,0 +1,65 @@
+package stock
+
+import (
+	"fmt"
+	"regexp"
+	"sync"
+)
+
+// Rules validates codes against per-type regex patterns. The patterns are
+// read through a lookup hook on every call, so configuration reloads take
+// effect without rebuilding the rules.
+type Rules struct {
+	mu     sync.Mutex
+	lookup func(crownType string) string
+	warn   func(crownType string, err error)
+	cache  map[string]*regexp.Regexp
+}
+
+// NewRules builds rules over a pattern lookup; warn, when non-nil, is
+// called once per broken pattern before it is treated as absent.
+func NewRules(lookup func(crownType string) string, warn func(crownType string, err error)) *Rules {
+	return &Rules{
+		lookup: lookup,
+		warn:   warn,
+		cache:  map[string]*regexp.Regexp{},
+	}
+}
+
+// ruleFor returns the compiled validation rule for a crown type, or nil
+// when none is configured.
+func (r *Rules) ruleFor(crownType string) *regexp.Regexp {
+	pattern := r.lookup(crownType)
+	if pattern == "" {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if re, ok := r.cache[pattern]; ok {
+		return re
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		if r.warn != nil {
+			r.warn(crownType, err)
+		}
+		re = nil
+	}
+	r.cache[pattern] = re
+	return re
+}
+
+// Validate checks a code against the configured rule for its type.
+func (r *Rules) Validate(crownType, code string) error {
+	if code == "" {
+		return fmt.Errorf("empty code")
+	}
+	re := r.ruleFor(crownType)
+	if re == nil {
+		return nil
+	}
+	if !re.MatchString(code) {
+		return fmt.Errorf("does not match %s rule %q", crownType, re.String())
+	}
+	return nil
+}
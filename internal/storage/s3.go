@@ -0,0 +1,40 @@
+package storage
+
+import (
+	"context"
+
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+)
+
+// S3 stores artifacts in an S3-compatible bucket (AWS, MinIO, Backblaze).
+type S3 struct {
+	Endpoint  string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	UseSSL    bool
+	// Prefix is prepended to every object name, e.g. "crownpal/exports".
+	Prefix string
+}
+
+func (s S3) Name() string { return "s3://" + s.Bucket }
+
+func (s S3) Put(ctx context.Context, localPath, remoteName string) error {
+	if s.Endpoint == "" {
+		return errMissing("s3", "endpoint")
+	}
+	if s.Bucket == "" {
+		return errMissing("s3", "bucket")
+	}
+	client, err := minio.New(s.Endpoint, &minio.Options{
+		Creds:  credentials.NewStaticV4(s.AccessKey, s.SecretKey, ""),
+		Secure: s.UseSSL,
+	})
+	if err != nil {
+		return err
+	}
+	_, err = client.FPutObject(ctx, s.Bucket, joinPrefix(s.Prefix, remoteName), localPath,
+		minio.PutObjectOptions{ContentType: contentTypeFor(localPath)})
+	return err
+}
@@ -0,0 +1,101 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/sftp"
+	"golang.org/x/crypto/ssh"
+)
+
+// SFTP stores artifacts on an SSH server. Authentication is a password,
+// a private key file, or both; set HostKey (authorized_keys format) to
+// pin the server's key — without it any host key is accepted, which is
+// only acceptable on trusted networks.
+type SFTP struct {
+	// Addr is host:port; port 22 must be given explicitly.
+	Addr     string
+	User     string
+	Password string
+	KeyFile  string
+	HostKey  string
+	// Dir is the remote directory, created when missing; empty uploads
+	// into the login directory.
+	Dir string
+}
+
+func (s SFTP) Name() string { return "sftp://" + s.Addr }
+
+func (s SFTP) Put(ctx context.Context, localPath, remoteName string) error {
+	if s.Addr == "" {
+		return errMissing("sftp", "addr")
+	}
+	if s.User == "" {
+		return errMissing("sftp", "user")
+	}
+	cfg := &ssh.ClientConfig{
+		User:            s.User,
+		HostKeyCallback: ssh.InsecureIgnoreHostKey(),
+		Timeout:         30 * time.Second,
+	}
+	if s.HostKey != "" {
+		key, _, _, _, err := ssh.ParseAuthorizedKey([]byte(s.HostKey))
+		if err != nil {
+			return fmt.Errorf("sftp storage: bad host_key: %w", err)
+		}
+		cfg.HostKeyCallback = ssh.FixedHostKey(key)
+	}
+	if s.Password != "" {
+		cfg.Auth = append(cfg.Auth, ssh.Password(s.Password))
+	}
+	if s.KeyFile != "" {
+		pem, err := os.ReadFile(s.KeyFile)
+		if err != nil {
+			return err
+		}
+		signer, err := ssh.ParsePrivateKey(pem)
+		if err != nil {
+			return fmt.Errorf("sftp storage: bad key file: %w", err)
+		}
+		cfg.Auth = append(cfg.Auth, ssh.PublicKeys(signer))
+	}
+	if len(cfg.Auth) == 0 {
+		return errMissing("sftp", "password or key_file")
+	}
+
+	conn, err := ssh.Dial("tcp", s.Addr, cfg)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	client, err := sftp.NewClient(conn)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	remote := remoteName
+	if s.Dir != "" {
+		if err := client.MkdirAll(s.Dir); err != nil {
+			return err
+		}
+		remote = s.Dir + "/" + remoteName
+	}
+	src, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := client.Create(remote)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
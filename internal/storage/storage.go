@@ -0,0 +1,73 @@
+// Package storage abstracts where finished artifacts — exports, backups
+// and reports — end up. The application writes files locally first (the
+// exporters need seekable files) and then hands them to one or more
+// backends; headless deployments point the backends at S3 or SFTP so
+// nothing of value stays on the box.
+package storage
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"os"
+	"path/filepath"
+)
+
+// Backend stores one finished artifact. Implementations must be safe for
+// concurrent use.
+type Backend interface {
+	// Name identifies the destination in status lines, e.g. "s3://bucket".
+	Name() string
+	// Put copies the local file to the backend under remoteName.
+	Put(ctx context.Context, localPath, remoteName string) error
+}
+
+// contentTypeFor guesses a MIME type from the file extension.
+func contentTypeFor(path string) string {
+	if ct := mime.TypeByExtension(filepath.Ext(path)); ct != "" {
+		return ct
+	}
+	return "application/octet-stream"
+}
+
+// Local copies artifacts into a directory, typically a mounted share or
+// a synced folder. It doubles as the reference implementation.
+type Local struct {
+	Dir string
+}
+
+func (l Local) Name() string { return l.Dir }
+
+func (l Local) Put(ctx context.Context, localPath, remoteName string) error {
+	if err := os.MkdirAll(l.Dir, 0755); err != nil {
+		return err
+	}
+	src, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	dst, err := os.Create(filepath.Join(l.Dir, remoteName))
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	return dst.Close()
+}
+
+// joinPrefix prepends an optional remote directory/prefix with "/".
+func joinPrefix(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "/" + name
+}
+
+// errMissing standardizes the error for required but empty settings.
+func errMissing(backend, field string) error {
+	return fmt.Errorf("%s storage: %s is not configured", backend, field)
+}
@@ -0,0 +1,296 @@
+// Package storedb maintains the single SQLite database every finder run
+// lands in: stores keyed by URL, their validation history, run metadata
+// and URL aliases. Exports read views over this database instead of
+// scattering state across timestamped files.
+package storedb
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	_ "modernc.org/sqlite"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// migrations run in order on open; PRAGMA user_version records the last
+// applied one, so adding a statement here upgrades existing databases.
+var migrations = []string{
+	`CREATE TABLE stores (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		url TEXT NOT NULL UNIQUE,
+		name TEXT NOT NULL,
+		source TEXT,
+		category TEXT,
+		trust_score REAL,
+		confidence REAL,
+		first_seen TEXT NOT NULL,
+		last_seen TEXT NOT NULL
+	);
+	CREATE TABLE runs (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		mode TEXT NOT NULL,
+		started_at TEXT NOT NULL,
+		duration_seconds INTEGER NOT NULL,
+		stores INTEGER NOT NULL,
+		errors INTEGER NOT NULL
+	);
+	CREATE TABLE validations (
+		id INTEGER PRIMARY KEY AUTOINCREMENT,
+		store_id INTEGER NOT NULL REFERENCES stores(id),
+		run_id INTEGER NOT NULL REFERENCES runs(id),
+		checked_at TEXT NOT NULL,
+		verified INTEGER NOT NULL,
+		has_paypal INTEGER NOT NULL,
+		instant_delivery INTEGER NOT NULL
+	);
+	CREATE TABLE aliases (
+		url TEXT PRIMARY KEY,
+		store_id INTEGER NOT NULL REFERENCES stores(id)
+	);
+	CREATE INDEX idx_validations_run ON validations(run_id);`,
+}
+
+// DB is an open store database.
+type DB struct {
+	sql *sql.DB
+}
+
+// Open opens (creating if needed) the database at path and applies any
+// pending migrations.
+func Open(path string) (*DB, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := db.Exec("PRAGMA foreign_keys = ON"); err != nil {
+		db.Close()
+		return nil, err
+	}
+	d := &DB{sql: db}
+	if err := d.migrate(); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *DB) Close() error { return d.sql.Close() }
+
+// migrate applies every migration past the recorded user_version.
+func (d *DB) migrate() error {
+	var version int
+	if err := d.sql.QueryRow("PRAGMA user_version").Scan(&version); err != nil {
+		return err
+	}
+	if version > len(migrations) {
+		return fmt.Errorf("database is at schema version %d, this build understands up to %d",
+			version, len(migrations))
+	}
+	for ; version < len(migrations); version++ {
+		tx, err := d.sql.Begin()
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Exec(migrations[version]); err != nil {
+			tx.Rollback()
+			return fmt.Errorf("migration %d: %w", version+1, err)
+		}
+		// PRAGMA does not take placeholders.
+		if _, err := tx.Exec(fmt.Sprintf("PRAGMA user_version = %d", version+1)); err != nil {
+			tx.Rollback()
+			return err
+		}
+		if err := tx.Commit(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RecordRun stores one finished run: stores are upserted by URL (aliases
+// resolve to their canonical row), each gets a validation row for this
+// run, and the run metadata is recorded. It returns the new run id.
+func (d *DB) RecordRun(mode string, start time.Time, duration time.Duration, errors int, results []search.Result) (int64, error) {
+	tx, err := d.sql.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+
+	res, err := tx.Exec(
+		"INSERT INTO runs (mode, started_at, duration_seconds, stores, errors) VALUES (?, ?, ?, ?, ?)",
+		mode, start.Format(time.RFC3339), int(duration.Seconds()), len(results), errors)
+	if err != nil {
+		return 0, err
+	}
+	runID, err := res.LastInsertId()
+	if err != nil {
+		return 0, err
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	for _, r := range results {
+		storeID, err := upsertStore(tx, r, now)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := tx.Exec(
+			`INSERT INTO validations (store_id, run_id, checked_at, verified, has_paypal, instant_delivery)
+			 VALUES (?, ?, ?, ?, ?, ?)`,
+			storeID, runID, now, r.Verified, r.HasPayPal, r.InstantDelivery); err != nil {
+			return 0, err
+		}
+	}
+	return runID, tx.Commit()
+}
+
+// upsertStore resolves the result to its canonical store row, creating or
+// refreshing it, and returns the store id.
+func upsertStore(tx *sql.Tx, r search.Result, now string) (int64, error) {
+	var id int64
+	err := tx.QueryRow("SELECT store_id FROM aliases WHERE url = ?", r.URL).Scan(&id)
+	if err == nil {
+		// An alias points elsewhere; only freshen the canonical row.
+		_, err = tx.Exec("UPDATE stores SET last_seen = ? WHERE id = ?", now, id)
+		return id, err
+	}
+	if err != sql.ErrNoRows {
+		return 0, err
+	}
+	if _, err := tx.Exec(
+		`INSERT INTO stores (url, name, source, category, trust_score, confidence, first_seen, last_seen)
+		 VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+		 ON CONFLICT(url) DO UPDATE SET
+			name = excluded.name,
+			source = excluded.source,
+			category = excluded.category,
+			trust_score = excluded.trust_score,
+			confidence = excluded.confidence,
+			last_seen = excluded.last_seen`,
+		r.URL, r.Name, r.Source, r.Category, r.TrustScore, r.Confidence, now, now); err != nil {
+		return 0, err
+	}
+	err = tx.QueryRow("SELECT id FROM stores WHERE url = ?", r.URL).Scan(&id)
+	return id, err
+}
+
+// PruneStale deletes stores (and their validations and aliases) last seen
+// before the cutoff, returning how many were removed.
+func (d *DB) PruneStale(cutoff time.Time) (int, error) {
+	tx, err := d.sql.Begin()
+	if err != nil {
+		return 0, err
+	}
+	defer tx.Rollback()
+	when := cutoff.Format(time.RFC3339)
+	if _, err := tx.Exec(
+		"DELETE FROM validations WHERE store_id IN (SELECT id FROM stores WHERE last_seen < ?)", when); err != nil {
+		return 0, err
+	}
+	if _, err := tx.Exec(
+		"DELETE FROM aliases WHERE store_id IN (SELECT id FROM stores WHERE last_seen < ?)", when); err != nil {
+		return 0, err
+	}
+	res, err := tx.Exec("DELETE FROM stores WHERE last_seen < ?", when)
+	if err != nil {
+		return 0, err
+	}
+	n, _ := res.RowsAffected()
+	return int(n), tx.Commit()
+}
+
+// AddAlias records that aliasURL is the same store as canonicalURL, so
+// future runs merge instead of duplicating.
+func (d *DB) AddAlias(aliasURL, canonicalURL string) error {
+	var id int64
+	if err := d.sql.QueryRow("SELECT id FROM stores WHERE url = ?", canonicalURL).Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return fmt.Errorf("no store with url %s", canonicalURL)
+		}
+		return err
+	}
+	_, err := d.sql.Exec("INSERT OR REPLACE INTO aliases (url, store_id) VALUES (?, ?)", aliasURL, id)
+	return err
+}
+
+// LatestRunID returns the most recent run id, 0 when none is recorded.
+func (d *DB) LatestRunID() (int64, error) {
+	var id sql.NullInt64
+	if err := d.sql.QueryRow("SELECT MAX(id) FROM runs").Scan(&id); err != nil {
+		return 0, err
+	}
+	return id.Int64, nil
+}
+
+// RunRow is one row of the run history view.
+type RunRow struct {
+	ID      int64
+	Mode    string
+	Started time.Time
+	Elapsed time.Duration
+	Stores  int
+	Errors  int
+}
+
+// Runs lists recorded runs, newest first, capped to limit (0 = all).
+func (d *DB) Runs(limit int) ([]RunRow, error) {
+	query := "SELECT id, mode, started_at, duration_seconds, stores, errors FROM runs ORDER BY id DESC"
+	if limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", limit)
+	}
+	rows, err := d.sql.Query(query)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var runs []RunRow
+	for rows.Next() {
+		var r RunRow
+		var started string
+		var seconds int
+		if err := rows.Scan(&r.ID, &r.Mode, &started, &seconds, &r.Stores, &r.Errors); err != nil {
+			return nil, err
+		}
+		r.Started, _ = time.Parse(time.RFC3339, started)
+		r.Elapsed = time.Duration(seconds) * time.Second
+		runs = append(runs, r)
+	}
+	return runs, rows.Err()
+}
+
+// Results reads the stores of one run back as results — the view the
+// export formats render. runID 0 means the latest run.
+func (d *DB) Results(runID int64) ([]search.Result, error) {
+	if runID == 0 {
+		var err error
+		if runID, err = d.LatestRunID(); err != nil {
+			return nil, err
+		}
+		if runID == 0 {
+			return nil, fmt.Errorf("no runs recorded yet")
+		}
+	}
+	rows, err := d.sql.Query(
+		`SELECT s.name, s.url, s.source, s.category, s.trust_score, s.confidence,
+			v.verified, v.has_paypal, v.instant_delivery, v.checked_at
+		 FROM validations v JOIN stores s ON s.id = v.store_id
+		 WHERE v.run_id = ? ORDER BY s.trust_score DESC`, runID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var results []search.Result
+	for rows.Next() {
+		var r search.Result
+		var checked string
+		if err := rows.Scan(&r.Name, &r.URL, &r.Source, &r.Category, &r.TrustScore,
+			&r.Confidence, &r.Verified, &r.HasPayPal, &r.InstantDelivery, &checked); err != nil {
+			return nil, err
+		}
+		r.FoundAt, _ = time.Parse(time.RFC3339, checked)
+		results = append(results, r)
+	}
+	return results, rows.Err()
+}
@@ -0,0 +1,27 @@
+package validate
+
+import (
+	"context"
+	"net"
+	"regexp"
+	"strings"
+)
+
+var emailRe = regexp.MustCompile(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`)
+
+// EmailSyntax reports whether the address is well-formed.
+func EmailSyntax(email string) bool {
+	return emailRe.MatchString(email)
+}
+
+// EmailDomain returns the domain part of an address.
+func EmailDomain(email string) string {
+	return email[strings.LastIndex(email, "@")+1:]
+}
+
+// HasMX reports whether the domain publishes at least one MX record.
+// Cancelling ctx aborts an in-flight lookup.
+func HasMX(ctx context.Context, domain string) bool {
+	mx, err := net.DefaultResolver.LookupMX(ctx, domain)
+	return err == nil && len(mx) > 0
+}
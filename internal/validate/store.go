@@ -0,0 +1,81 @@
+// Package validate holds the checks the app runs against things it
+// discovered or was given: store liveness/feature probing and email
+// address validation (syntax plus MX — deliberately nothing that touches
+// credentials).
+package validate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// Store performs a lightweight liveness/feature check on found stores.
+// It satisfies search.StoreValidator.
+type Store struct {
+	client search.Doer
+	env    search.Env
+}
+
+// NewStore builds a store validator; a nil client gets a default with a
+// 10s timeout. Tests pass a fake search.Doer.
+func NewStore(client search.Doer, env search.Env) *Store {
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &Store{client: client, env: env}
+}
+
+// Validate marks the result verified if the site answers, and sniffs for
+// PayPal / instant-delivery mentions on the landing page.
+func (v *Store) Validate(ctx context.Context, r *search.Result) error {
+	if v.env.DryRun != nil && v.env.DryRun("validate "+r.URL) {
+		return nil
+	}
+	_, span := otel.Tracer("crownpal").Start(ctx, "validate",
+		trace.WithAttributes(attribute.String("url", r.URL)))
+	defer span.End()
+	req, err := http.NewRequestWithContext(ctx, "GET", r.URL, nil)
+	if err != nil {
+		return err
+	}
+	start := time.Now()
+	resp, err := v.client.Do(req)
+	if err != nil {
+		v.onRequest(0, 0, time.Since(start))
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 512*1024))
+	v.onRequest(resp.StatusCode, int64(len(body)), time.Since(start))
+	if resp.StatusCode >= 400 {
+		return fmt.Errorf("status %d", resp.StatusCode)
+	}
+	if err != nil {
+		return err
+	}
+	page := strings.ToLower(string(body))
+	r.Verified = true
+	r.HasPayPal = strings.Contains(page, "paypal")
+	r.InstantDelivery = strings.Contains(page, "instant delivery") || strings.Contains(page, "instant download")
+	r.Confidence += 0.4
+	if r.Confidence > 1 {
+		r.Confidence = 1
+	}
+	return nil
+}
+
+func (v *Store) onRequest(status int, bytes int64, elapsed time.Duration) {
+	if v.env.OnRequest != nil {
+		v.env.OnRequest("validator", status, bytes, elapsed)
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/kvcache"
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+const kvCacheFile = "cache.db"
+
+// Per-bucket lifetimes. Pages go stale fast; MX records and validation
+// verdicts are good for much longer; the seen-set tracks which stores
+// count as "new" between runs.
+const (
+	httpCacheTTL     = 15 * time.Minute
+	mxCacheTTL       = 24 * time.Hour
+	validateCacheTTL = 6 * time.Hour
+	seenStoreTTL     = 30 * 24 * time.Hour
+)
+
+// kv is the process-wide persistent cache backing the HTTP page cache,
+// the MX and store-validation caches and the cross-run seen-sets. It is
+// nil when the file could not be opened (for instance a second instance
+// holds the lock), in which case everything runs uncached.
+var kv *kvcache.Cache
+
+// openKVCache (re)opens the cache for the active workspace.
+func openKVCache() {
+	kv.Close()
+	c, err := kvcache.Open(statePath(kvCacheFile), kvcache.Options{})
+	if err != nil {
+		fmt.Printf("⚠️  Cache unavailable: %v (running uncached)\n", err)
+		kv = nil
+		return
+	}
+	kv = c
+}
+
+// pageCache adapts kv to the engine's search.ByteCache with the page TTL.
+type pageCache struct{}
+
+func (pageCache) Get(bucket, key string) ([]byte, bool) { return kv.Get(bucket, key) }
+
+func (pageCache) Set(bucket, key string, value []byte) { kv.Set(bucket, key, value, httpCacheTTL) }
+
+// cachedMX wraps an MX lookup with the persistent "mx" bucket, so domain
+// verdicts survive between checker runs.
+func cachedMX(domain string, lookup func() bool) bool {
+	if data, hit := kv.Get("mx", domain); hit {
+		return len(data) == 1 && data[0] == 1
+	}
+	ok := lookup()
+	verdict := []byte{0}
+	if ok {
+		verdict[0] = 1
+	}
+	kv.Set("mx", domain, verdict, mxCacheTTL)
+	return ok
+}
+
+// cachedValidation is what the "validate" bucket remembers per store URL:
+// only the fields the validators own, so fresh scrape data (name, price
+// category, trust score) is never overwritten by a stale copy.
+type cachedValidation struct {
+	Verified        bool    `json:"verified"`
+	HasPayPal       bool    `json:"has_paypal"`
+	InstantDelivery bool    `json:"instant_delivery"`
+	Confidence      float64 `json:"confidence"`
+}
+
+// cachedValidator serves recent verdicts from the cache and only sends
+// unseen (or expired) stores down the real validator chain.
+type cachedValidator struct {
+	next search.StoreValidator
+}
+
+func (v cachedValidator) Validate(ctx context.Context, r *StoreResult) error {
+	if data, hit := kv.Get("validate", r.URL); hit {
+		var cached cachedValidation
+		if json.Unmarshal(data, &cached) == nil {
+			r.Verified = cached.Verified
+			r.HasPayPal = cached.HasPayPal
+			r.InstantDelivery = cached.InstantDelivery
+			r.Confidence = cached.Confidence
+			return nil
+		}
+	}
+	if err := v.next.Validate(ctx, r); err != nil {
+		// Failures are not cached: the next run should retry.
+		return err
+	}
+	if data, err := json.Marshal(cachedValidation{
+		Verified:        r.Verified,
+		HasPayPal:       r.HasPayPal,
+		InstantDelivery: r.InstantDelivery,
+		Confidence:      r.Confidence,
+	}); err == nil {
+		kv.Set("validate", r.URL, data, validateCacheTTL)
+	}
+	return nil
+}
+
+// countNewStores marks every result in the cross-run seen-set and returns
+// how many were never seen before (within seenStoreTTL).
+func countNewStores(results []StoreResult) int {
+	fresh := 0
+	for _, r := range results {
+		if !kv.Seen("stores", r.URL, seenStoreTTL) {
+			fresh++
+		}
+	}
+	return fresh
+}
+
+func init() {
+	cacheCmd := &cobra.Command{
+		Use:   "cache",
+		Short: "Inspect or clear the persistent cache",
+	}
+	cacheCmd.AddCommand(&cobra.Command{
+		Use:   "stats",
+		Short: "Show live entry counts per cache bucket",
+		Run: func(cmd *cobra.Command, args []string) {
+			counts := kv.Stats()
+			if len(counts) == 0 {
+				fmt.Println("📦 Cache is empty")
+				return
+			}
+			for bucket, n := range counts {
+				fmt.Printf("📦 %s: %d entries\n", bucket, n)
+			}
+		},
+	})
+	cacheCmd.AddCommand(&cobra.Command{
+		Use:   "clear [bucket...]",
+		Short: "Drop cache buckets (all of them without arguments)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := kv.Clear(args...); err != nil {
+				return err
+			}
+			fmt.Println("✅ Cache cleared")
+			return nil
+		},
+	})
+	cacheCmd.AddCommand(&cobra.Command{
+		Use:   "sweep",
+		Short: "Evict expired entries now instead of lazily",
+		Run: func(cmd *cobra.Command, args []string) {
+			fmt.Printf("✅ %d expired entries removed\n", kv.Sweep())
+		},
+	})
+	rootCmd.AddCommand(cacheCmd)
+}
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"path/filepath"
+)
+
+const (
+	// logFileName lives in the data directory next to the other state.
+	logFileName = "crownpal.log"
+	// logMaxBytes triggers a rotation on startup once the file passes it.
+	logMaxBytes = 5 << 20
+	// logKeep is how many rotated files are kept.
+	logKeep = 3
+)
+
+// logger is the structured diagnostic log. Console output stays the
+// human-friendly emoji prints; everything worth grepping later also goes
+// here. Starts discarding so code paths running before initLogging
+// (config loading) can log unconditionally.
+var logger = slog.New(slog.NewTextHandler(io.Discard, nil))
+
+// initLogging opens the rotating log file and installs the real logger.
+func initLogging() {
+	path := filepath.Join(dataDir(), logFileName)
+	rotateLogs(path)
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o644)
+	if err != nil {
+		fmt.Printf("⚠️  Cannot open log file %s: %v\n", path, err)
+		return
+	}
+	level := slog.LevelInfo
+	if flagVerbose {
+		level = slog.LevelDebug
+	}
+	logger = slog.New(slog.NewJSONHandler(f, &slog.HandlerOptions{Level: level}))
+}
+
+// rotateLogs shifts crownpal.log → .1 → .2 … once the current file has
+// outgrown logMaxBytes, dropping the oldest.
+func rotateLogs(path string) {
+	info, err := os.Stat(path)
+	if err != nil || info.Size() < logMaxBytes {
+		return
+	}
+	for i := logKeep - 1; i >= 1; i-- {
+		os.Rename(fmt.Sprintf("%s.%d", path, i), fmt.Sprintf("%s.%d", path, i+1))
+	}
+	os.Rename(path, path+".1")
+}
@@ -0,0 +1,141 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+)
+
+// headless is set when running a subcommand: no prompt may block, all
+// inputs come from flags and config, output goes to stdout and files.
+var headless bool
+
+func main() {
+	err := rootCmd.Execute()
+	shutdownTelemetry()
+	if err != nil {
+		fmt.Printf("❌ %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// runInteractive starts the menu-driven app; config is already loaded by
+// the root command.
+func runInteractive() {
+	enableVirtualTerminal()
+	if _, err := os.Stat(configPath()); os.IsNotExist(err) {
+		runSetupWizard()
+	}
+	startExpirySweeper()
+	handleInterrupt()
+	offerRecovery()
+	runTUIMenu()
+}
+
+// handleInterrupt makes the first Ctrl+C cancel running work through
+// appCtx so partial results get flushed, and stops background jobs and
+// saves the stock. A second Ctrl+C force-quits.
+func handleInterrupt() {
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, os.Interrupt)
+	go func() {
+		<-ch
+		fmt.Println("\n⚠️  Interrupted — finishing up, flushing partial results (Ctrl+C again to force quit)")
+		appCancel()
+		stopAutoRestock()
+		saveStockSnapshot()
+		<-ch
+		fmt.Println("\n❌ Forced quit")
+		os.Exit(1)
+	}()
+}
+
+// mainMenu is the plain Scanln menu, kept as a fallback for terminals the
+// TUI cannot run on.
+func mainMenu() {
+	for {
+		clearScreen()
+		fmt.Println("╔══════════════════════════════════════╗")
+		fmt.Println("║            CROWNPAL v1.0             ║")
+		fmt.Println("╠══════════════════════════════════════╣")
+		fmt.Printf("║  [1] %-32s║\n", tr("menu.finder"))
+		fmt.Printf("║  [2] %-32s║\n", tr("menu.checker"))
+		fmt.Printf("║  [3] %-32s║\n", tr("menu.stock"))
+		fmt.Printf("║  [4] %-32s║\n", tr("menu.telegram"))
+		fmt.Printf("║  [5] %-32s║\n", tr("menu.stats"))
+		fmt.Printf("║  [6] %-32s║\n", tr("menu.query"))
+		fmt.Printf("║  [7] %-32s║\n", tr("menu.compare"))
+		fmt.Printf("║  [8] %-32s║\n", tr("menu.import"))
+		fmt.Printf("║  [9] %-32s║\n", tr("menu.profile"))
+		fmt.Printf("║ [10] %-32s║\n", tr("menu.history"))
+		fmt.Printf("║ [11] %-32s║\n", tr("menu.repeat"))
+		fmt.Printf("║ [12] %-32s║\n", tr("menu.runs"))
+		fmt.Printf("║  [0] %-32s║\n", tr("menu.exit"))
+		fmt.Println("╚══════════════════════════════════════╝")
+		choice := promptChoice(tr("prompt.choice"), 0, 12)
+
+		switch choice {
+		case 1:
+			cdKeyFinderMode()
+		case 2:
+			emailCheckerMode()
+		case 3:
+			crownStockManagement()
+		case 4:
+			telegramMode()
+		case 5:
+			viewStatistics()
+		case 6:
+			queryResultsMenu()
+		case 7:
+			compareResultsMenu()
+		case 8:
+			importStoresMenu()
+		case 9:
+			switchProfileMenu()
+		case 10:
+			sessionHistoryMenu()
+		case 11:
+			repeatLastRun()
+		case 12:
+			runHistoryMenu()
+		case 0:
+			fmt.Println(tr("msg.bye"))
+			os.Exit(0)
+		}
+		if interrupted() {
+			fmt.Println(tr("msg.bye"))
+			return
+		}
+	}
+}
+
+// clearScreen clears the terminal and homes the cursor with ANSI escape
+// sequences; enableVirtualTerminal makes these work on Windows consoles.
+func clearScreen() {
+	fmt.Print("\x1b[2J\x1b[H")
+}
+
+func viewStatistics() {
+	clearScreen()
+	fmt.Println(tr("stats.header"))
+	fmt.Println("── This session ──")
+	checked, valid := session.checkerTotals()
+	fmt.Println(trf("stats.checked", checked))
+	fmt.Println(trf("stats.valid", valid))
+	fmt.Println(trf("stats.stock", len(stock)))
+	printStockSummary(stock)
+	printSchedules()
+	printLifetimeStats()
+	printRunTrends()
+	printProviderMetrics()
+	fmt.Println("══════════════════════════════════")
+	promptLine(tr("prompt.continue"))
+}
+
+func pause() {
+	if headless {
+		return
+	}
+	promptLine("\n" + tr("prompt.continue"))
+}
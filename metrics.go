@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ProviderMetrics accumulates per-provider request counters for one process.
+type ProviderMetrics struct {
+	Requests     int            `json:"requests"`
+	StatusCodes  map[string]int `json:"status_codes"`
+	Bytes        int64          `json:"bytes"`
+	TotalLatency time.Duration  `json:"-"`
+	AvgLatencyMS float64        `json:"avg_latency_ms"`
+}
+
+var (
+	metricsMu       sync.Mutex
+	providerMetrics = make(map[string]*ProviderMetrics)
+)
+
+// recordRequest notes one HTTP request made on behalf of a provider.
+// status 0 means the request failed before a response arrived.
+func recordRequest(provider string, status int, bytes int64, latency time.Duration) {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	m := providerMetrics[provider]
+	if m == nil {
+		m = &ProviderMetrics{StatusCodes: make(map[string]int)}
+		providerMetrics[provider] = m
+	}
+	m.Requests++
+	if status == 0 {
+		m.StatusCodes["error"]++
+	} else {
+		m.StatusCodes[fmt.Sprintf("%d", status)]++
+	}
+	m.Bytes += bytes
+	m.TotalLatency += latency
+	m.AvgLatencyMS = float64(m.TotalLatency.Milliseconds()) / float64(m.Requests)
+}
+
+// printProviderMetrics renders the per-provider table in the statistics view.
+func printProviderMetrics() {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	if len(providerMetrics) == 0 {
+		return
+	}
+	names := make([]string, 0, len(providerMetrics))
+	for name := range providerMetrics {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Println("\n── Provider metrics ──")
+	fmt.Printf("%-18s %8s %10s %12s  %s\n", "provider", "reqs", "KB", "avg ms", "status codes")
+	for _, name := range names {
+		m := providerMetrics[name]
+		codes := make([]string, 0, len(m.StatusCodes))
+		for code, n := range m.StatusCodes {
+			codes = append(codes, fmt.Sprintf("%s:%d", code, n))
+		}
+		sort.Strings(codes)
+		fmt.Printf("%-18s %8d %10.1f %12.1f  %s\n",
+			name, m.Requests, float64(m.Bytes)/1024, m.AvgLatencyMS, joinCodes(codes))
+	}
+}
+
+func joinCodes(codes []string) string {
+	out := ""
+	for i, c := range codes {
+		if i > 0 {
+			out += " "
+		}
+		out += c
+	}
+	return out
+}
+
+// saveMetrics writes the per-provider metrics snapshot as JSON.
+func saveMetrics(filename string) error {
+	metricsMu.Lock()
+	defer metricsMu.Unlock()
+	data, err := json.MarshalIndent(providerMetrics, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filename, data)
+}
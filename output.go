@@ -0,0 +1,48 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// runID identifies this process in filename templates.
+var runID = newRunID()
+
+func newRunID() string {
+	var b [4]byte
+	rand.Read(b[:])
+	return hex.EncodeToString(b[:])
+}
+
+const defaultFilenameTemplate = "{kind}_{date}_{time}.{ext}"
+
+// outputPath expands the configured filename template for one output file
+// and makes sure its directory exists. Supported placeholders: {kind},
+// {ext}, {date}, {time}, {run-id}.
+func outputPath(kind, ext string) string {
+	tmpl := config.FilenameTemplate
+	if tmpl == "" {
+		tmpl = defaultFilenameTemplate
+	}
+	now := time.Now()
+	expander := strings.NewReplacer(
+		"{kind}", kind,
+		"{ext}", ext,
+		"{date}", now.Format("2006-01-02"),
+		"{time}", now.Format("150405"),
+		"{run-id}", runID,
+	)
+	path := filepath.Join(config.OutputDir, expander.Replace(tmpl))
+	if dir := filepath.Dir(path); dir != "." {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			fmt.Printf("⚠️  Cannot create %s: %v (using current directory)\n", dir, err)
+			return filepath.Base(path)
+		}
+	}
+	return path
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+)
+
+// appName names the per-application subdirectory under the platform
+// config/data/cache directories.
+const appName = "crownpal"
+
+// configDir is where config files live: the platform config directory
+// (XDG_CONFIG_HOME, %AppData%, ~/Library/Application Support).
+func configDir() string {
+	base, err := os.UserConfigDir()
+	if err != nil {
+		return "."
+	}
+	return ensureDir(filepath.Join(base, appName))
+}
+
+// dataDir is where persistent state lives (stock, ledgers, session
+// history): XDG_DATA_HOME on Linux, the config directory elsewhere.
+func dataDir() string {
+	if base := os.Getenv("XDG_DATA_HOME"); base != "" {
+		return ensureDir(filepath.Join(base, appName))
+	}
+	if home, err := os.UserHomeDir(); err == nil {
+		if info, statErr := os.Stat(filepath.Join(home, ".local", "share")); statErr == nil && info.IsDir() {
+			return ensureDir(filepath.Join(home, ".local", "share", appName))
+		}
+	}
+	return configDir()
+}
+
+// cacheDir is where logs and other regenerable files live.
+func cacheDir() string {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "."
+	}
+	return ensureDir(filepath.Join(base, appName))
+}
+
+// ensureDir creates the directory if needed, falling back to the working
+// directory when it cannot.
+func ensureDir(dir string) string {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "."
+	}
+	return dir
+}
+
+// statePath resolves where a persistent file lives. An active workspace
+// fully owns its state; otherwise a file already present in the working
+// directory keeps winning so setups from before the platform directories
+// existed don't silently lose their data.
+func statePath(name string) string {
+	if activeWorkspace != "" {
+		return filepath.Join(workspaceDir(), name)
+	}
+	if _, err := os.Stat(name); err == nil {
+		return name
+	}
+	return filepath.Join(dataDir(), name)
+}
+
+// configFilePath resolves a config file the same way, against the
+// platform config directory.
+func configFilePath(name string) string {
+	if _, err := os.Stat(name); err == nil {
+		return name
+	}
+	return filepath.Join(configDir(), name)
+}
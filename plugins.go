@@ -0,0 +1,213 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/search"
+)
+
+// Plugins are executables dropped into the plugins/ directory; they speak
+// JSON over stdio, one request per process run. At startup each gets
+// {"op":"describe"} and answers {"name":..., "roles":[...]}. Roles:
+//
+//	provider  — {"op":"search"} → {"results":[store, ...]}
+//	validator — {"op":"validate","store":{...}} → {"store":{...}} or {"error":...}
+//	notifier  — {"op":"notify","channel":...,"message":...} → {}
+//
+// Stores use the same JSON layout as the results export.
+
+// pluginInfo is one discovered plugin.
+type pluginInfo struct {
+	Name  string
+	Path  string
+	Roles []string
+}
+
+var loadedPlugins []pluginInfo
+
+// pluginRequest is the JSON message written to a plugin's stdin.
+type pluginRequest struct {
+	Op      string       `json:"op"`
+	Store   *StoreResult `json:"store,omitempty"`
+	Channel string       `json:"channel,omitempty"`
+	Message string       `json:"message,omitempty"`
+}
+
+// pluginReply is the JSON a plugin prints on stdout before exiting; each
+// op fills the fields it needs.
+type pluginReply struct {
+	Name    string        `json:"name,omitempty"`
+	Roles   []string      `json:"roles,omitempty"`
+	Results []StoreResult `json:"results,omitempty"`
+	Store   *StoreResult  `json:"store,omitempty"`
+	Error   string        `json:"error,omitempty"`
+}
+
+// callPlugin runs the executable once with the request on stdin and
+// decodes its stdout; stderr ends up in the error on failure.
+func callPlugin(path string, req pluginRequest, reply *pluginReply, timeout time.Duration) error {
+	input, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(appCtx, timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, path)
+	cmd.Stdin = bytes.NewReader(input)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%v: %s", err, msg)
+		}
+		return err
+	}
+	if err := json.Unmarshal(stdout.Bytes(), reply); err != nil {
+		return fmt.Errorf("bad plugin reply: %v", err)
+	}
+	if reply.Error != "" {
+		return errors.New(reply.Error)
+	}
+	return nil
+}
+
+// loadPlugins discovers executables in the plugins directory, asks each to
+// describe itself and wires its roles in. A broken plugin is reported and
+// skipped, never fatal.
+func loadPlugins() {
+	entries, err := os.ReadDir(statePath("plugins"))
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		info, err := e.Info()
+		if err != nil || (runtime.GOOS != "windows" && info.Mode()&0o111 == 0) {
+			continue
+		}
+		path := filepath.Join(statePath("plugins"), e.Name())
+		var desc pluginReply
+		if err := callPlugin(path, pluginRequest{Op: "describe"}, &desc, 10*time.Second); err != nil {
+			fmt.Printf("⚠️  Plugin %s: %v\n", e.Name(), err)
+			continue
+		}
+		name := desc.Name
+		if name == "" {
+			name = e.Name()
+		}
+		p := pluginInfo{Name: name, Path: path, Roles: desc.Roles}
+		loadedPlugins = append(loadedPlugins, p)
+		for _, role := range desc.Roles {
+			switch role {
+			case "provider":
+				registerPluginProvider(p)
+			case "validator", "notifier":
+				// Consulted at call time via pluginsWithRole.
+			default:
+				fmt.Printf("⚠️  Plugin %s declares unknown role %q\n", name, role)
+			}
+		}
+		logger.Info("plugin loaded", "name", name, "roles", desc.Roles)
+		fmt.Printf("🔄 Plugin loaded: %s (%s)\n", name, strings.Join(desc.Roles, ", "))
+	}
+}
+
+// pluginsWithRole filters the discovered plugins by role.
+func pluginsWithRole(role string) []pluginInfo {
+	var matched []pluginInfo
+	for _, p := range loadedPlugins {
+		for _, r := range p.Roles {
+			if r == role {
+				matched = append(matched, p)
+				break
+			}
+		}
+	}
+	return matched
+}
+
+// registerPluginProvider hangs the plugin onto the search engine as an
+// extra provider; the engine merges and tallies its batch like any other.
+func registerPluginProvider(p pluginInfo) {
+	source := "plugin:" + p.Name
+	search.RegisterProvider(search.Provider{
+		Name: source,
+		Scrape: func(ctx context.Context, _ *search.Scraper, stats *search.Stats) []search.Result {
+			if dryRunSkip("plugin " + p.Name + " search") {
+				return nil
+			}
+			var reply pluginReply
+			if err := callPlugin(p.Path, pluginRequest{Op: "search"}, &reply, 2*time.Minute); err != nil {
+				stats.RecordError(err)
+				fmt.Printf("⚠️  Plugin %s: %v\n", p.Name, err)
+				return nil
+			}
+			for i := range reply.Results {
+				if reply.Results[i].Source == "" {
+					reply.Results[i].Source = source
+				}
+				if reply.Results[i].FoundAt.IsZero() {
+					reply.Results[i].FoundAt = time.Now()
+				}
+			}
+			return reply.Results
+		},
+	})
+}
+
+// withPluginValidators chains validator plugins after the built-in
+// checks; with none discovered the base validator is returned untouched.
+func withPluginValidators(base search.StoreValidator) search.StoreValidator {
+	plugins := pluginsWithRole("validator")
+	if len(plugins) == 0 {
+		return base
+	}
+	return &pluginValidatorChain{base: base, plugins: plugins}
+}
+
+type pluginValidatorChain struct {
+	base    search.StoreValidator
+	plugins []pluginInfo
+}
+
+func (c *pluginValidatorChain) Validate(ctx context.Context, r *StoreResult) error {
+	if err := c.base.Validate(ctx, r); err != nil {
+		return err
+	}
+	for _, p := range c.plugins {
+		var reply pluginReply
+		if err := callPlugin(p.Path, pluginRequest{Op: "validate", Store: r}, &reply, 30*time.Second); err != nil {
+			return fmt.Errorf("plugin %s: %w", p.Name, err)
+		}
+		if reply.Store != nil {
+			*r = *reply.Store
+		}
+	}
+	return nil
+}
+
+// notifyPlugins fans a channel message out to every notifier plugin.
+func notifyPlugins(kind, message string) {
+	for _, p := range pluginsWithRole("notifier") {
+		if dryRunSkip("plugin " + p.Name + " notify") {
+			continue
+		}
+		var reply pluginReply
+		if err := callPlugin(p.Path, pluginRequest{Op: "notify", Channel: kind, Message: message}, &reply, 30*time.Second); err != nil {
+			fmt.Printf("⚠️  Plugin %s: %v\n", p.Name, err)
+		}
+	}
+}
@@ -0,0 +1,20 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	_ "net/http/pprof"
+)
+
+// startPprof exposes the net/http/pprof handlers on addr so CPU and heap
+// behavior of 100-worker runs can be inspected with go tool pprof while
+// the run is in flight. Bound to localhost by the flag default; the
+// handlers expose stack traces, so don't put this on a public interface.
+func startPprof(addr string) {
+	go func() {
+		fmt.Printf("🔍 pprof listening on http://%s/debug/pprof/\n", addr)
+		if err := http.ListenAndServe(addr, nil); err != nil {
+			fmt.Printf("⚠️  pprof server: %v\n", err)
+		}
+	}()
+}
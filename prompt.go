@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// stdin is the shared line reader all prompts go through, so invalid
+// input never leaves garbage behind for the next read the way bare
+// fmt.Scanln calls did.
+var stdin = bufio.NewReader(os.Stdin)
+
+// promptLine prints the label and returns the next input line, trimmed.
+// Once stdin is gone (EOF or a read error) no prompt can ever be answered
+// again, so it saves the stock and exits instead of handing empty strings
+// to callers that would re-prompt forever.
+func promptLine(label string) string {
+	fmt.Print(label)
+	line, err := stdin.ReadString('\n')
+	if err != nil && line == "" {
+		fmt.Println("\n⚠️  Input closed — exiting")
+		saveStockSnapshot()
+		os.Exit(0)
+	}
+	return strings.TrimSpace(line)
+}
+
+// promptChoice reads a menu choice, re-prompting until it gets a number
+// between min and max.
+func promptChoice(label string, min, max int) int {
+	for {
+		raw := promptLine(label)
+		n, err := strconv.Atoi(raw)
+		if err == nil && n >= min && n <= max {
+			return n
+		}
+		fmt.Println(trf("err.number_range", min, max))
+	}
+}
+
+// promptIntDefault reads a number, returning the default on empty or
+// invalid input.
+func promptIntDefault(label string, def int) int {
+	raw := promptLine(label)
+	if n, err := strconv.Atoi(raw); err == nil {
+		return n
+	}
+	return def
+}
+
+// promptYes reports whether the user answered yes.
+func promptYes(label string) bool {
+	answer := promptLine(label)
+	return strings.EqualFold(answer, "y") || strings.EqualFold(answer, "yes")
+}
+
+// promptLines reads input lines until an empty one; EOF ends the batch
+// like an empty line, keeping whatever was read.
+func promptLines() []string {
+	var lines []string
+	for {
+		line, err := stdin.ReadString('\n')
+		line = strings.TrimRight(line, "\r\n")
+		if strings.TrimSpace(line) == "" {
+			return lines
+		}
+		lines = append(lines, line)
+		if err != nil {
+			return lines
+		}
+	}
+}
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// restockJob tracks the background auto-restock goroutine so the menu
+// stays usable while it runs.
+type restockJob struct {
+	mu       sync.Mutex
+	running  bool
+	source   string
+	interval time.Duration
+	dryRun   bool
+	lastRun  time.Time
+	stop     chan struct{}
+}
+
+// restock is the process-wide auto-restock job; only one runs at a time.
+var restock restockJob
+
+// startAutoRestock launches the restock loop in the background and
+// returns immediately; it fails if a job is already running.
+func startAutoRestock(source string, interval time.Duration, dryRun bool) error {
+	restock.mu.Lock()
+	defer restock.mu.Unlock()
+	if restock.running {
+		return fmt.Errorf("auto restock already running (source %s)", restock.source)
+	}
+	restock.running = true
+	restock.source = source
+	restock.interval = interval
+	restock.dryRun = dryRun
+	restock.lastRun = time.Time{}
+	restock.stop = make(chan struct{})
+	go restock.loop(source, interval, dryRun, restock.stop)
+	return nil
+}
+
+func (j *restockJob) loop(source string, interval time.Duration, dryRun bool, stop <-chan struct{}) {
+	for {
+		if !dryRun {
+			journalStock("auto restock " + source)
+		}
+		added, skipped, invalid := restockOnce(source, dryRun)
+		logger.Info("restock cycle", "source", source, "added", added,
+			"skipped", skipped, "invalid", invalid, "dry_run", dryRun)
+		if !dryRun {
+			notifyRestock(source, added, skipped, invalid)
+		}
+		j.mu.Lock()
+		j.lastRun = time.Now()
+		j.mu.Unlock()
+		select {
+		case <-time.After(interval):
+		case <-stop:
+			return
+		}
+	}
+}
+
+// restockOnce runs one restock cycle: a directory source drains dropped
+// files into stock, anything else re-imports a file or URL directly. In
+// dry-run mode nothing is imported or archived, only reported.
+func restockOnce(source string, dryRun bool) (added, skipped, invalid int) {
+	if info, err := os.Stat(source); err == nil && info.IsDir() {
+		return importFromDirectory(source, dryRun)
+	}
+	if dryRun {
+		return previewImport(source)
+	}
+	return importStock(source)
+}
+
+// previewImport reports what importing the source would do — new codes,
+// duplicates, invalid lines — without mutating stock.
+func previewImport(source string) (added, skipped, invalid int) {
+	data, err := readStockSource(source)
+	if err != nil {
+		fmt.Printf("❌ Cannot read %s: %v\n", source, err)
+		return
+	}
+	batch, invalid, err := parseStockCrowns(source, data)
+	if err != nil {
+		fmt.Printf("❌ Invalid stock file %s: %v\n", source, err)
+		return 0, 0, invalid
+	}
+	existing := make(map[string]bool)
+	for _, c := range store.List() {
+		existing[c.Code] = true
+	}
+	for _, c := range batch {
+		if existing[c.Code] || (config.RejectConsumedImports && isConsumed(c.Code)) {
+			skipped++
+			continue
+		}
+		existing[c.Code] = true
+		added++
+	}
+	fmt.Printf("🔍 Dry run %s: %d would be added, %d duplicates, %d invalid\n",
+		source, added, skipped, invalid)
+	return added, skipped, invalid
+}
+
+// importFromDirectory imports every regular file in dir and moves it into
+// dir/archive/, so the next cycle only sees newly dropped supplier files.
+func importFromDirectory(dir string, dryRun bool) (added, skipped, invalid int) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		fmt.Printf("❌ Cannot read %s: %v\n", dir, err)
+		return
+	}
+	archive := filepath.Join(dir, "archive")
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		path := filepath.Join(dir, e.Name())
+		if dryRun {
+			a, s, i := previewImport(path)
+			added += a
+			skipped += s
+			invalid += i
+			continue
+		}
+		a, s, i := importStock(path)
+		added += a
+		skipped += s
+		invalid += i
+		if err := os.MkdirAll(archive, 0755); err != nil {
+			fmt.Printf("⚠️  Cannot create %s: %v\n", archive, err)
+			return
+		}
+		dest := filepath.Join(archive, e.Name())
+		if _, err := os.Stat(dest); err == nil {
+			// A same-named file was archived before; keep both.
+			dest = filepath.Join(archive, time.Now().Format("20060102_150405_")+e.Name())
+		}
+		if err := os.Rename(path, dest); err != nil {
+			fmt.Printf("⚠️  Cannot archive %s: %v\n", path, err)
+		}
+	}
+	return
+}
+
+// notifyRestock posts a per-cycle summary (codes added, duplicates, new
+// per-pool totals) to the configured channel when restock_notify is on.
+func notifyRestock(source string, added, skipped, invalid int) {
+	if !config.RestockNotify || (added == 0 && skipped == 0 && invalid == 0) {
+		return
+	}
+	pools := make(map[string]int)
+	for _, c := range store.List() {
+		if !c.Used {
+			pools[c.Pool]++
+		}
+	}
+	names := make([]string, 0, len(pools))
+	for name := range pools {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "🔄 Restock from %s\n", escapeTelegram(source))
+	fmt.Fprintf(&b, "Added %d, %d duplicates, %d invalid\n", added, skipped, invalid)
+	for _, name := range names {
+		label := name
+		if label == "" {
+			label = "default"
+		}
+		fmt.Fprintf(&b, "%s: %d unused\n", escapeTelegram(label), pools[name])
+	}
+	notifyChannel("stock", b.String())
+}
+
+// stopAutoRestock signals the loop to exit; it reports whether a job was
+// actually running.
+func stopAutoRestock() bool {
+	restock.mu.Lock()
+	defer restock.mu.Unlock()
+	if !restock.running {
+		return false
+	}
+	close(restock.stop)
+	restock.running = false
+	return true
+}
+
+// statusLine describes the job for menu headers; empty when idle.
+func (j *restockJob) statusLine() string {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	if !j.running {
+		return ""
+	}
+	last := "not yet"
+	if !j.lastRun.IsZero() {
+		last = j.lastRun.Format("15:04:05")
+	}
+	mode := ""
+	if j.dryRun {
+		mode = ", dry run"
+	}
+	return fmt.Sprintf("🔄 Auto restock active: %s every %s (last run %s%s)", j.source, j.interval, last, mode)
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// restockListener guards against starting the listener twice.
+var (
+	restockListenerMu sync.Mutex
+	restockListenerOn bool
+)
+
+// startRestockListener serves POST /restock so other systems can push
+// codes into stock. It refuses to start without a configured shared token.
+func startRestockListener(addr string) error {
+	restockListenerMu.Lock()
+	defer restockListenerMu.Unlock()
+	if restockListenerOn {
+		return fmt.Errorf("restock listener already running")
+	}
+	if config.RestockToken == "" {
+		return fmt.Errorf("set restock_token in %s first", configPath())
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/restock", handleRestock)
+	server := &http.Server{
+		Addr:         addr,
+		Handler:      mux,
+		ReadTimeout:  time.Minute,
+		WriteTimeout: 30 * time.Second,
+	}
+	restockListenerOn = true
+	go func() {
+		if err := server.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+			fmt.Printf("\n❌ Restock listener: %v\n", err)
+		}
+		restockListenerMu.Lock()
+		restockListenerOn = false
+		restockListenerMu.Unlock()
+	}()
+	return nil
+}
+
+// handleRestock accepts a JSON stock export or a plain code-per-line body
+// and adds the codes to the pool given by the ?pool= query parameter.
+func handleRestock(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "POST only", http.StatusMethodNotAllowed)
+		return
+	}
+	token := strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if subtle.ConstantTimeCompare([]byte(token), []byte(config.RestockToken)) != 1 {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+	body, err := io.ReadAll(io.LimitReader(r.Body, 32*1024*1024))
+	if err != nil {
+		http.Error(w, "read body: "+err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	pool := r.URL.Query().Get("pool")
+	var batch []Crown
+	invalid := 0
+	if strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		crowns, err := decodeStockJSON(body)
+		if err != nil {
+			http.Error(w, "invalid json: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+		for _, c := range crowns {
+			if validateCode(c.Type, c.Code) != nil {
+				invalid++
+				continue
+			}
+			if c.AddedAt.IsZero() {
+				c.AddedAt = time.Now()
+			}
+			c.Pool = pool
+			batch = append(batch, c)
+		}
+	} else {
+		for _, line := range strings.Split(string(body), "\n") {
+			code := strings.TrimSpace(line)
+			if code == "" {
+				continue
+			}
+			if validateCode("generic", code) != nil {
+				invalid++
+				continue
+			}
+			batch = append(batch, Crown{Code: code, Type: "generic", Pool: pool, AddedAt: time.Now()})
+		}
+	}
+
+	journalStock("http restock from " + r.RemoteAddr)
+	added, skipped := store.Add(batch...)
+	fmt.Printf("\n📦 HTTP restock from %s: %d added, %d duplicates, %d invalid\n",
+		r.RemoteAddr, added, skipped, invalid)
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(map[string]int{
+		"added":   added,
+		"skipped": skipped,
+		"invalid": invalid,
+		"total":   store.Count(),
+	})
+}
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// browserPageSize is how many stores the browser shows at once.
+const browserPageSize = 20
+
+// browserModel is the Bubble Tea model for the post-search results
+// browser: scrolling, sorting, text filter, a per-store detail view and
+// favorite marking.
+type browserModel struct {
+	all     []StoreResult
+	rows    []StoreResult
+	cursor  int
+	offset  int
+	filter  string
+	typing  bool
+	sortKey string
+	detail  bool
+	favs    map[string]bool
+}
+
+func newBrowserModel(results []StoreResult) browserModel {
+	m := browserModel{all: results, sortKey: "trust", favs: make(map[string]bool)}
+	m.refresh()
+	return m
+}
+
+// refresh recomputes the visible rows from the filter and sort key.
+func (m *browserModel) refresh() {
+	m.rows = m.rows[:0]
+	query := strings.ToLower(m.filter)
+	for _, r := range m.all {
+		if query == "" ||
+			strings.Contains(strings.ToLower(r.Name), query) ||
+			strings.Contains(strings.ToLower(r.URL), query) {
+			m.rows = append(m.rows, r)
+		}
+	}
+	switch m.sortKey {
+	case "confidence":
+		sort.Slice(m.rows, func(i, j int) bool { return m.rows[i].Confidence > m.rows[j].Confidence })
+	case "name":
+		sort.Slice(m.rows, func(i, j int) bool { return m.rows[i].Name < m.rows[j].Name })
+	default:
+		sort.Slice(m.rows, func(i, j int) bool { return m.rows[i].TrustScore > m.rows[j].TrustScore })
+	}
+	if m.cursor >= len(m.rows) {
+		m.cursor = len(m.rows) - 1
+	}
+	if m.cursor < 0 {
+		m.cursor = 0
+	}
+	m.offset = 0
+}
+
+func (m browserModel) Init() tea.Cmd { return nil }
+
+func (m browserModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	key, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	if m.typing {
+		switch key.String() {
+		case "enter", "esc":
+			m.typing = false
+		case "backspace":
+			if m.filter != "" {
+				m.filter = m.filter[:len(m.filter)-1]
+			}
+			m.refresh()
+		default:
+			if len(key.String()) == 1 {
+				m.filter += key.String()
+				m.refresh()
+			}
+		}
+		return m, nil
+	}
+
+	switch key.String() {
+	case "up", "k":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "down", "j":
+		if m.cursor < len(m.rows)-1 {
+			m.cursor++
+		}
+	case "/":
+		m.typing = true
+		m.detail = false
+	case "s":
+		switch m.sortKey {
+		case "trust":
+			m.sortKey = "confidence"
+		case "confidence":
+			m.sortKey = "name"
+		default:
+			m.sortKey = "trust"
+		}
+		m.refresh()
+	case "enter":
+		m.detail = !m.detail
+	case "f":
+		if m.cursor < len(m.rows) {
+			url := m.rows[m.cursor].URL
+			m.favs[url] = !m.favs[url]
+		}
+	case "q", "ctrl+c", "esc":
+		return m, tea.Quit
+	}
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.cursor >= m.offset+browserPageSize {
+		m.offset = m.cursor - browserPageSize + 1
+	}
+	return m, nil
+}
+
+func (m browserModel) View() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "🔍 %d of %d stores · sort: %s · filter: %s",
+		len(m.rows), len(m.all), m.sortKey, m.filter)
+	if m.typing {
+		b.WriteString("▌")
+	}
+	b.WriteString("\n\n")
+
+	end := m.offset + browserPageSize
+	if end > len(m.rows) {
+		end = len(m.rows)
+	}
+	for i := m.offset; i < end; i++ {
+		r := m.rows[i]
+		cursor := "  "
+		if i == m.cursor {
+			cursor = "› "
+		}
+		fav := " "
+		if m.favs[r.URL] {
+			fav = "★"
+		}
+		fmt.Fprintf(&b, "%s%s %-30.30s %-10s %5.1f %5.2f\n",
+			cursor, fav, r.Name, r.Source, r.TrustScore, r.Confidence)
+	}
+
+	if m.detail && m.cursor < len(m.rows) {
+		r := m.rows[m.cursor]
+		fmt.Fprintf(&b, "\n── %s ──\nURL: %s\nCategory: %s\nTrust: %.1f · Confidence: %.2f\nPayPal: %v · Instant: %v · Verified: %v\nFound: %s\n",
+			r.Name, r.URL, r.Category, r.TrustScore, r.Confidence,
+			r.HasPayPal, r.InstantDelivery, r.Verified, r.FoundAt.Format("2006-01-02 15:04"))
+		for k, v := range r.Extra {
+			fmt.Fprintf(&b, "%s: %s\n", k, v)
+		}
+	}
+
+	b.WriteString("\n↑/↓ move · / filter · s sort · enter detail · f favorite · q quit\n")
+	return b.String()
+}
+
+// browseResults opens the interactive browser and saves any favorites the
+// user marked when it closes.
+func browseResults(results []StoreResult) {
+	if len(results) == 0 {
+		return
+	}
+	final, err := tea.NewProgram(newBrowserModel(results)).Run()
+	if err != nil {
+		fmt.Printf("❌ Browser: %v\n", err)
+		return
+	}
+	favs := final.(browserModel).favs
+	var marked []StoreResult
+	for _, r := range results {
+		if favs[r.URL] {
+			marked = append(marked, r)
+		}
+	}
+	if len(marked) == 0 {
+		return
+	}
+	path := outputPath("favorites", "json")
+	if err := SaveResultsJSON(marked, path); err != nil {
+		fmt.Printf("❌ Cannot save favorites: %v\n", err)
+		return
+	}
+	fmt.Printf("⭐ %d favorites saved to %s\n", len(marked), path)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// StoreChange records one store whose status differs between two runs.
+type StoreChange struct {
+	Store   StoreResult
+	Changes []string
+}
+
+// ResultsDiff is the outcome of comparing two result files.
+type ResultsDiff struct {
+	Added   []StoreResult
+	Removed []StoreResult
+	Changed []StoreChange
+}
+
+// DiffResults compares two result sets by store URL.
+func DiffResults(oldResults, newResults []StoreResult) ResultsDiff {
+	oldByURL := make(map[string]StoreResult, len(oldResults))
+	for _, r := range oldResults {
+		oldByURL[r.URL] = r
+	}
+	newByURL := make(map[string]StoreResult, len(newResults))
+	for _, r := range newResults {
+		newByURL[r.URL] = r
+	}
+
+	var diff ResultsDiff
+	for _, r := range newResults {
+		prev, ok := oldByURL[r.URL]
+		if !ok {
+			diff.Added = append(diff.Added, r)
+			continue
+		}
+		var changes []string
+		if prev.HasPayPal != r.HasPayPal {
+			changes = append(changes, fmt.Sprintf("paypal %t→%t", prev.HasPayPal, r.HasPayPal))
+		}
+		if prev.InstantDelivery != r.InstantDelivery {
+			changes = append(changes, fmt.Sprintf("instant %t→%t", prev.InstantDelivery, r.InstantDelivery))
+		}
+		if prev.Verified != r.Verified {
+			changes = append(changes, fmt.Sprintf("verified %t→%t", prev.Verified, r.Verified))
+		}
+		if prev.TrustScore != r.TrustScore {
+			changes = append(changes, fmt.Sprintf("trust %.1f→%.1f", prev.TrustScore, r.TrustScore))
+		}
+		if len(changes) > 0 {
+			diff.Changed = append(diff.Changed, StoreChange{Store: r, Changes: changes})
+		}
+	}
+	for _, r := range oldResults {
+		if _, ok := newByURL[r.URL]; !ok {
+			diff.Removed = append(diff.Removed, r)
+		}
+	}
+	return diff
+}
+
+// compareResultsMenu prompts for two result files and prints/exports a diff.
+func compareResultsMenu() {
+	clearScreen()
+	fmt.Println("═══════════ COMPARE RESULT FILES ═══════════")
+	oldPath := promptLine("Older result file: ")
+	newPath := promptLine("Newer result file: ")
+
+	oldResults, err := LoadResultsJSON(oldPath)
+	if err != nil {
+		fmt.Printf("❌ Cannot load %s: %v\n", oldPath, err)
+		pause()
+		return
+	}
+	newResults, err := LoadResultsJSON(newPath)
+	if err != nil {
+		fmt.Printf("❌ Cannot load %s: %v\n", newPath, err)
+		pause()
+		return
+	}
+
+	diff := DiffResults(oldResults, newResults)
+	printDiff(diff)
+
+	if promptYes("\nSave HTML diff report? (y/n): ") {
+		filename := outputPath("diff", "html")
+		if err := SaveDiffHTML(diff, filename); err != nil {
+			fmt.Printf("❌ Export failed: %v\n", err)
+		} else {
+			fmt.Printf("💾 Saved to %s\n", filename)
+		}
+	}
+	pause()
+}
+
+func printDiff(diff ResultsDiff) {
+	fmt.Printf("\n🆕 New stores (%d):\n", len(diff.Added))
+	for _, r := range diff.Added {
+		fmt.Printf("   + %s (%s)\n", r.Name, r.URL)
+	}
+	fmt.Printf("\n💀 Disappeared stores (%d):\n", len(diff.Removed))
+	for _, r := range diff.Removed {
+		fmt.Printf("   - %s (%s)\n", r.Name, r.URL)
+	}
+	fmt.Printf("\n🔀 Changed stores (%d):\n", len(diff.Changed))
+	for _, c := range diff.Changed {
+		fmt.Printf("   ~ %s: %s\n", c.Store.Name, strings.Join(c.Changes, ", "))
+	}
+}
+
+// SaveDiffHTML writes the diff as a small standalone HTML report.
+func SaveDiffHTML(diff ResultsDiff, filename string) error {
+	var sb strings.Builder
+	sb.WriteString("<!DOCTYPE html>\n<html><head><meta charset=\"utf-8\"><title>Run diff</title>")
+	sb.WriteString("<style>body{font-family:sans-serif}h2{color:#dc143c}</style></head><body>\n")
+	section := func(title string, stores []StoreResult) {
+		sb.WriteString(fmt.Sprintf("<h2>%s (%d)</h2>\n<ul>\n", title, len(stores)))
+		for _, r := range stores {
+			sb.WriteString(fmt.Sprintf("<li><a href=\"%s\">%s</a></li>\n", r.URL, r.Name))
+		}
+		sb.WriteString("</ul>\n")
+	}
+	section("New stores", diff.Added)
+	section("Disappeared stores", diff.Removed)
+	sb.WriteString(fmt.Sprintf("<h2>Changed stores (%d)</h2>\n<ul>\n", len(diff.Changed)))
+	for _, c := range diff.Changed {
+		sb.WriteString(fmt.Sprintf("<li><a href=\"%s\">%s</a>: %s</li>\n",
+			c.Store.URL, c.Store.Name, strings.Join(c.Changes, ", ")))
+	}
+	sb.WriteString("</ul>\n</body></html>\n")
+	return writeFileAtomic(filename, []byte(sb.String()))
+}
@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// promptExportFormats asks which formats to export, falling back to the
+// configured default (or json). Several formats may be given at once; the
+// written paths are returned.
+func promptExportFormats(results []StoreResult) []string {
+	defaults := config.ExportFormats
+	if len(defaults) == 0 {
+		defaults = []string{"json"}
+	}
+	answer := promptLine(fmt.Sprintf("Export formats [json txt csv xlsx md html pdf sqlite template] (default %s): ",
+		strings.Join(defaults, ",")))
+
+	formats := defaults
+	if answer != "" {
+		formats = nil
+		for _, f := range strings.Split(answer, ",") {
+			if f = strings.TrimSpace(f); f != "" {
+				formats = append(formats, f)
+			}
+		}
+	}
+	var files []string
+	for _, format := range formats {
+		if file := exportResults(results, format); file != "" {
+			files = append(files, file)
+		}
+	}
+	offerChannelUpload(files)
+	return files
+}
+
+// exportFilteredMenu lets the user stack filters over the just-finished
+// search results and export only the matching subset.
+func exportFilteredMenu(results []StoreResult) {
+	if !promptYes("\nExport a filtered subset? (y/n): ") {
+		return
+	}
+
+	subset := results
+	for {
+		fmt.Printf("\nCurrent subset: %d of %d stores\n", len(subset), len(results))
+		fmt.Println("[1] Only PayPal")
+		fmt.Println("[2] Only verified")
+		fmt.Println("[3] Minimum confidence")
+		fmt.Println("[4] Only a source")
+		fmt.Println("[5] Reset filters")
+		fmt.Println("[0] Done, export subset")
+
+		switch promptChoice("Choice: ", 0, 5) {
+		case 1:
+			subset = FilterByPayPal(subset)
+		case 2:
+			subset = FilterByVerified(subset)
+		case 3:
+			min, err := strconv.ParseFloat(promptLine("Minimum confidence (0-1): "), 64)
+			if err != nil {
+				fmt.Println("❌ Invalid number")
+				continue
+			}
+			subset = FilterByConfidence(subset, min)
+		case 4:
+			subset = FilterBySource(subset, promptLine("Source name: "))
+		case 5:
+			subset = results
+		case 0:
+			if len(subset) == 0 {
+				fmt.Println("❌ Nothing matches, not exporting")
+				return
+			}
+			format := promptLine("Format (json/txt/csv/xlsx/md/html/pdf): ")
+			if format == "" {
+				format = "json"
+			}
+			exportResults(subset, format)
+			return
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// runsFile records one entry per finished run with its outcome — unlike
+// session_history.json, which only keeps the parameters needed to repeat
+// a run.
+const runsFile = "runs.json"
+
+// runsMax caps how many run records the file keeps.
+const runsMax = 200
+
+// runRecord summarizes one finished finder or checker run.
+type runRecord struct {
+	Mode     string            `json:"mode"`
+	Start    time.Time         `json:"start"`
+	Duration string            `json:"duration"`
+	Params   map[string]string `json:"params,omitempty"`
+	Counts   map[string]int    `json:"counts,omitempty"`
+	Exports  []string          `json:"exports,omitempty"`
+	// Series holds per-sample progress counters ("stores", "qps") taken
+	// while the run was active, for trend rendering and later charting.
+	Series map[string][]int `json:"series,omitempty"`
+}
+
+// describe renders a record for the run history list.
+func (r runRecord) describe() string {
+	s := fmt.Sprintf("%s %-8s %8s", r.Start.Format("2006-01-02 15:04"), r.Mode, r.Duration)
+	for k, v := range r.Counts {
+		s += fmt.Sprintf(" %s=%d", k, v)
+	}
+	return s
+}
+
+// loadRuns reads past run records; a missing file is an empty history.
+func loadRuns() []runRecord {
+	data, err := os.ReadFile(statePath(runsFile))
+	if err != nil {
+		return nil
+	}
+	var runs []runRecord
+	if err := json.Unmarshal(data, &runs); err != nil {
+		return nil
+	}
+	return runs
+}
+
+// runsMu serializes recordRun's read-modify-write of runsFile: scheduled
+// jobs finish on their own goroutines, and two concurrent appends would
+// drop one record.
+var runsMu sync.Mutex
+
+// recordRun appends a finished run to the runs database.
+func recordRun(r runRecord) {
+	runsMu.Lock()
+	defer runsMu.Unlock()
+	runs := append(loadRuns(), r)
+	if len(runs) > runsMax {
+		runs = runs[len(runs)-runsMax:]
+	}
+	data, err := json.MarshalIndent(runs, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(runsFile), data); err != nil {
+		fmt.Printf("⚠️  Could not save run history: %v\n", err)
+	}
+}
+
+// runHistoryMenu lists past runs and re-opens the exported results of the
+// chosen one in the results browser.
+func runHistoryMenu() {
+	clearScreen()
+	fmt.Println("═══════════ RUN HISTORY ═══════════")
+	runs := loadRuns()
+	if len(runs) == 0 {
+		fmt.Println("📦 No runs recorded yet")
+		pause()
+		return
+	}
+	// Newest first.
+	for i := len(runs) - 1; i >= 0; i-- {
+		fmt.Printf("[%d] %s\n", len(runs)-i, runs[i].describe())
+	}
+	fmt.Println("[0] Back")
+	choice := promptChoice("Open which run? ", 0, len(runs))
+	if choice == 0 {
+		return
+	}
+	run := runs[len(runs)-choice]
+	for _, path := range run.Exports {
+		fmt.Printf("💾 %s\n", path)
+	}
+	for _, path := range run.Exports {
+		if strings.HasSuffix(path, ".json") {
+			results, err := LoadResultsJSON(path)
+			if err != nil {
+				fmt.Printf("❌ Cannot load %s: %v\n", path, err)
+				break
+			}
+			browseResults(results)
+			break
+		}
+	}
+	pause()
+}
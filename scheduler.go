@@ -0,0 +1,229 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+)
+
+// ScheduleConfig is one recurring job from config.json, e.g.
+//
+//	{"name": "nightly search", "job": "search", "at": "03:00",
+//	 "params": {"target": "200", "format": "json,csv"}}
+type ScheduleConfig struct {
+	Name   string            `json:"name"`
+	Job    string            `json:"job"`
+	Params map[string]string `json:"params,omitempty"`
+	// Every runs the job on a fixed interval ("1h", "168h" for weekly).
+	Every string `json:"every,omitempty"`
+	// At runs the job daily at this local time ("03:00"); it wins over
+	// Every when both are set.
+	At string `json:"at,omitempty"`
+}
+
+// schedulerStateFile remembers when each schedule last ran, so restarts
+// don't re-fire interval jobs early.
+const schedulerStateFile = "scheduler.json"
+
+// scheduleEntry is one parsed schedule with its computed next run.
+type scheduleEntry struct {
+	cfg     ScheduleConfig
+	every   time.Duration
+	atValid bool
+	at      time.Duration // offset into the local day
+	lastRun time.Time
+	nextRun time.Time
+}
+
+var (
+	schedulerMu      sync.Mutex
+	scheduleEntries  []*scheduleEntry
+	schedulerRunning bool
+)
+
+// startScheduler parses config.Schedules and starts the run loop; invalid
+// entries are reported and skipped. Without valid schedules it does
+// nothing.
+func startScheduler() {
+	schedulerMu.Lock()
+	defer schedulerMu.Unlock()
+	if schedulerRunning || len(config.Schedules) == 0 {
+		return
+	}
+	lastRuns := loadSchedulerState()
+	for _, cfg := range config.Schedules {
+		entry, err := parseSchedule(cfg)
+		if err != nil {
+			fmt.Printf("⚠️  Schedule %q: %v\n", cfg.Name, err)
+			continue
+		}
+		entry.lastRun = lastRuns[cfg.Name]
+		entry.computeNext(time.Now())
+		scheduleEntries = append(scheduleEntries, entry)
+	}
+	if len(scheduleEntries) == 0 {
+		return
+	}
+	schedulerRunning = true
+	go schedulerLoop()
+	fmt.Printf("⏳ Scheduler running with %d job(s)\n", len(scheduleEntries))
+}
+
+// parseSchedule validates one config entry.
+func parseSchedule(cfg ScheduleConfig) (*scheduleEntry, error) {
+	if _, ok := jobKinds[cfg.Job]; !ok {
+		return nil, fmt.Errorf("unknown job kind %q", cfg.Job)
+	}
+	entry := &scheduleEntry{cfg: cfg}
+	if cfg.At != "" {
+		t, err := time.Parse("15:04", cfg.At)
+		if err != nil {
+			return nil, fmt.Errorf(`"at" must be HH:MM, got %q`, cfg.At)
+		}
+		entry.atValid = true
+		entry.at = time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+		return entry, nil
+	}
+	if cfg.Every == "" {
+		return nil, fmt.Errorf(`needs "every" (interval) or "at" (daily time)`)
+	}
+	every, err := time.ParseDuration(cfg.Every)
+	if err != nil || every < time.Minute {
+		return nil, fmt.Errorf(`"every" must be a duration of at least 1m, got %q`, cfg.Every)
+	}
+	entry.every = every
+	return entry, nil
+}
+
+// computeNext sets the entry's next run after now.
+func (e *scheduleEntry) computeNext(now time.Time) {
+	if e.atValid {
+		next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location()).Add(e.at)
+		if !next.After(now) {
+			next = next.AddDate(0, 0, 1)
+		}
+		e.nextRun = next
+		return
+	}
+	// Interval jobs anchor on the last run; a fresh schedule waits one
+	// full interval instead of firing at startup.
+	anchor := e.lastRun
+	if anchor.IsZero() {
+		anchor = now
+	}
+	next := anchor.Add(e.every)
+	if !next.After(now) {
+		next = now.Add(e.every)
+	}
+	e.nextRun = next
+}
+
+// schedulerLoop fires due schedules; jobs run one at a time.
+func schedulerLoop() {
+	ticker := time.NewTicker(30 * time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+		case <-appCtx.Done():
+			return
+		}
+		now := time.Now()
+		schedulerMu.Lock()
+		var due []*scheduleEntry
+		for _, e := range scheduleEntries {
+			if !now.Before(e.nextRun) {
+				due = append(due, e)
+			}
+		}
+		schedulerMu.Unlock()
+		for _, e := range due {
+			runScheduled(e)
+		}
+	}
+}
+
+// runScheduled executes one schedule's job and rolls its next-run time.
+func runScheduled(e *scheduleEntry) {
+	fmt.Printf("\n⏳ Scheduled job %q (%s) starting\n", e.cfg.Name, e.cfg.Job)
+	summary, err := executeJob(&daemonJob{Kind: e.cfg.Job, Params: e.cfg.Params})
+	if err != nil {
+		fmt.Printf("❌ Scheduled job %q failed: %v\n", e.cfg.Name, err)
+		logger.Error("scheduled job failed", "name", e.cfg.Name, "job", e.cfg.Job, "err", err)
+	} else {
+		fmt.Printf("✅ Scheduled job %q done: %s\n", e.cfg.Name, summary)
+		logger.Info("scheduled job done", "name", e.cfg.Name, "job", e.cfg.Job, "summary", summary)
+	}
+	schedulerMu.Lock()
+	e.lastRun = time.Now()
+	e.computeNext(e.lastRun)
+	saveSchedulerStateLocked()
+	schedulerMu.Unlock()
+}
+
+// loadSchedulerState reads the per-schedule last-run times.
+func loadSchedulerState() map[string]time.Time {
+	lastRuns := make(map[string]time.Time)
+	data, err := os.ReadFile(statePath(schedulerStateFile))
+	if err != nil {
+		return lastRuns
+	}
+	json.Unmarshal(data, &lastRuns)
+	return lastRuns
+}
+
+// saveSchedulerStateLocked persists last-run times; callers hold
+// schedulerMu.
+func saveSchedulerStateLocked() {
+	lastRuns := make(map[string]time.Time, len(scheduleEntries))
+	for _, e := range scheduleEntries {
+		if !e.lastRun.IsZero() {
+			lastRuns[e.cfg.Name] = e.lastRun
+		}
+	}
+	data, err := json.MarshalIndent(lastRuns, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(schedulerStateFile), data); err != nil {
+		fmt.Printf("⚠️  Could not save scheduler state: %v\n", err)
+	}
+}
+
+// printSchedules renders the schedule table with next-run times; nothing
+// when no scheduler is running.
+func printSchedules() {
+	schedulerMu.Lock()
+	defer schedulerMu.Unlock()
+	if len(scheduleEntries) == 0 {
+		return
+	}
+	fmt.Println("── Scheduled jobs ──")
+	for _, e := range scheduleEntries {
+		last := "never"
+		if !e.lastRun.IsZero() {
+			last = e.lastRun.Format("2006-01-02 15:04")
+		}
+		fmt.Printf("⏳ %-20s %-10s next %s (last %s)\n",
+			e.cfg.Name, e.cfg.Job, e.nextRun.Format("2006-01-02 15:04"), last)
+	}
+}
+
+func init() {
+	scheduleCmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "List configured recurring jobs and their next runs",
+		Run: func(cmd *cobra.Command, args []string) {
+			if len(scheduleEntries) == 0 {
+				fmt.Printf("📦 No schedules configured (add \"schedules\" to %s)\n", configPath())
+				return
+			}
+			printSchedules()
+		},
+	}
+	rootCmd.AddCommand(scheduleCmd)
+}
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+)
+
+// sessionHistoryFile keeps the parameters of past runs so recurring
+// workflows can be repeated without re-entering everything.
+const sessionHistoryFile = "session_history.json"
+
+// sessionHistoryMax caps how many entries the file keeps.
+const sessionHistoryMax = 50
+
+// sessionEntry records one run's parameters.
+type sessionEntry struct {
+	Mode   string            `json:"mode"`
+	Time   time.Time         `json:"time"`
+	Params map[string]string `json:"params"`
+}
+
+// describe renders an entry for the history list.
+func (e sessionEntry) describe() string {
+	s := fmt.Sprintf("%s %s", e.Time.Format("2006-01-02 15:04"), e.Mode)
+	for k, v := range e.Params {
+		s += fmt.Sprintf(" %s=%s", k, v)
+	}
+	return s
+}
+
+// loadSessionHistory reads past entries; a missing file is an empty history.
+func loadSessionHistory() []sessionEntry {
+	data, err := os.ReadFile(statePath(sessionHistoryFile))
+	if err != nil {
+		return nil
+	}
+	var entries []sessionEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return nil
+	}
+	return entries
+}
+
+// recordSession appends a run to the history file.
+func recordSession(mode string, params map[string]string) {
+	entries := append(loadSessionHistory(), sessionEntry{
+		Mode:   mode,
+		Time:   time.Now(),
+		Params: params,
+	})
+	if len(entries) > sessionHistoryMax {
+		entries = entries[len(entries)-sessionHistoryMax:]
+	}
+	data, err := json.MarshalIndent(entries, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(sessionHistoryFile), data); err != nil {
+		fmt.Printf("⚠️  Could not save session history: %v\n", err)
+	}
+}
+
+// repeatSession re-runs a recorded entry with its saved parameters.
+func repeatSession(e sessionEntry) {
+	fmt.Printf("🔄 Repeating: %s\n", e.describe())
+	switch e.Mode {
+	case "search":
+		target, _ := strconv.Atoi(e.Params["target"])
+		if target <= 0 {
+			target = 100
+		}
+		finderRun(target)
+	case "check":
+		checkEmails(e.Params["file"], new(CheckerStats))
+	case "stock_import":
+		journalStock("repeat import " + e.Params["source"])
+		importStock(e.Params["source"])
+	default:
+		fmt.Printf("❌ Don't know how to repeat mode %q\n", e.Mode)
+	}
+}
+
+// repeatLastRun repeats the newest history entry.
+func repeatLastRun() {
+	entries := loadSessionHistory()
+	if len(entries) == 0 {
+		fmt.Println("📦 No runs recorded yet")
+		pause()
+		return
+	}
+	repeatSession(entries[len(entries)-1])
+	pause()
+}
+
+// sessionHistoryMenu lists past runs and repeats the chosen one.
+func sessionHistoryMenu() {
+	clearScreen()
+	fmt.Println("═══════════ SESSION HISTORY ═══════════")
+	entries := loadSessionHistory()
+	if len(entries) == 0 {
+		fmt.Println("📦 No runs recorded yet")
+		pause()
+		return
+	}
+	// Newest first.
+	for i := len(entries) - 1; i >= 0; i-- {
+		fmt.Printf("[%d] %s\n", len(entries)-i, entries[i].describe())
+	}
+	fmt.Println("[0] Back")
+	choice := promptChoice("Repeat which run? ", 0, len(entries))
+	if choice == 0 {
+		return
+	}
+	repeatSession(entries[len(entries)-choice])
+	pause()
+}
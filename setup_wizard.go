@@ -0,0 +1,88 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/notify"
+)
+
+// runSetupWizard guides a first launch through the essential settings and
+// writes config.json. Every question can be skipped with Enter.
+func runSetupWizard() {
+	clearScreen()
+	fmt.Println("═══════════ FIRST-RUN SETUP ═══════════")
+	fmt.Println("No config.json found — answer a few questions (Enter to skip any).")
+	fmt.Println()
+
+	token := promptLine("Telegram bot token: ")
+	if token != "" {
+		if name, err := verifyTelegramToken(token); err != nil {
+			fmt.Printf("⚠️  Token check failed: %v (saved anyway)\n", err)
+		} else {
+			fmt.Printf("✅ Token OK, bot @%s\n", name)
+		}
+		config.TelegramToken = token
+	}
+
+	if channel := promptLine("Channel ID (e.g. @mychannel or -100...): "); channel != "" {
+		config.ChannelID = channel
+	}
+
+	if n := promptIntDefault(fmt.Sprintf("Worker threads (default %d): ", config.Threads), config.Threads); n > 0 {
+		config.Threads = n
+	}
+
+	for _, p := range strings.Split(promptLine("Proxies, comma-separated (empty for none): "), ",") {
+		if p = strings.TrimSpace(p); p != "" {
+			config.Proxies = append(config.Proxies, p)
+		}
+	}
+
+	if outputDir := promptLine("Output directory (empty for current): "); outputDir != "" {
+		config.OutputDir = outputDir
+	}
+
+	if err := saveConfig(); err != nil {
+		fmt.Printf("❌ Cannot write %s: %v\n", configPath(), err)
+	} else {
+		fmt.Printf("✅ Saved %s\n", configPath())
+	}
+	pause()
+}
+
+// verifyTelegramToken calls the Bot API getMe method and returns the bot
+// username the token belongs to.
+func verifyTelegramToken(token string) (string, error) {
+	req, err := http.NewRequestWithContext(appCtx, "GET",
+		fmt.Sprintf("%s/bot%s/getMe", telegramAPIBase, token), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := newHTTPClient(15 * time.Second).Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return "", err
+	}
+	var reply struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			Username string `json:"username"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return "", err
+	}
+	if !reply.OK {
+		return "", fmt.Errorf("%s", notify.ErrorDescription(body))
+	}
+	return reply.Result.Username, nil
+}
@@ -0,0 +1,18 @@
+package main
+
+import "context"
+
+// appCtx is cancelled on the first Ctrl+C so long-running work (scraping,
+// validation, email checking) can stop at the next item instead of being
+// killed mid-run. Whatever was collected so far is still exported.
+var appCtx, appCancel = context.WithCancel(context.Background())
+
+// interrupted reports whether shutdown has been requested.
+func interrupted() bool {
+	select {
+	case <-appCtx.Done():
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/notify"
+)
+
+// SMTPConfig configures email delivery of run reports.
+type SMTPConfig struct {
+	Host       string   `json:"host"`
+	Port       int      `json:"port"`
+	Username   string   `json:"username"`
+	Password   string   `json:"password"`
+	From       string   `json:"from"`
+	Recipients []string `json:"recipients"`
+}
+
+func (c SMTPConfig) enabled() bool {
+	return c.Host != "" && len(c.Recipients) > 0
+}
+
+// sendReportEmail emails the summary, attaching the export when given.
+// Failures are reported but never abort the run.
+func sendReportEmail(subject, body, attachment string) {
+	c := config.SMTP
+	if !c.enabled() {
+		return
+	}
+	mailer := notify.Mailer{
+		Host:       c.Host,
+		Port:       c.Port,
+		Username:   c.Username,
+		Password:   c.Password,
+		From:       c.From,
+		Recipients: c.Recipients,
+	}
+	if err := mailer.Send(subject, body, attachment); err != nil {
+		fmt.Printf("⚠️  Report email failed: %v\n", err)
+		return
+	}
+	fmt.Printf("📧 Report emailed to %s\n", strings.Join(c.Recipients, ", "))
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// sparkBars are the eight block heights a sparkline is drawn with.
+var sparkBars = []rune("▁▂▃▄▅▆▇█")
+
+// sparkline renders the values as a compact unicode trend line, scaled to
+// the largest value in the series.
+func sparkline(values []int) string {
+	if len(values) == 0 {
+		return ""
+	}
+	max := 0
+	for _, v := range values {
+		if v > max {
+			max = v
+		}
+	}
+	if max == 0 {
+		return strings.Repeat(string(sparkBars[0]), len(values))
+	}
+	var b strings.Builder
+	for _, v := range values {
+		i := v * (len(sparkBars) - 1) / max
+		b.WriteRune(sparkBars[i])
+	}
+	return b.String()
+}
+
+// printRunTrends renders sparklines for the most recent run that recorded
+// a time series.
+func printRunTrends() {
+	runs := loadRuns()
+	for i := len(runs) - 1; i >= 0; i-- {
+		r := runs[i]
+		if len(r.Series) == 0 {
+			continue
+		}
+		fmt.Printf("── Last run trends (%s %s) ──\n", r.Mode, r.Start.Format("2006-01-02 15:04"))
+		if s := r.Series["stores"]; len(s) > 0 {
+			fmt.Printf("Stores found:  %s (now %d)\n", sparkline(s), s[len(s)-1])
+		}
+		if s := r.Series["qps"]; len(s) > 0 {
+			fmt.Printf("Queries/sec:   %s\n", sparkline(s))
+		}
+		return
+	}
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// lifetimeStatsFile accumulates counters across sessions so statistics
+// survive a restart, bucketed per day.
+const lifetimeStatsFile = "lifetime_stats.json"
+
+// lifetimeStats is day → counter key → total, e.g.
+// {"2026-08-31": {"checker.checked": 1200, "finder.stores": 85}}.
+type lifetimeStats map[string]map[string]int
+
+// total sums a counter key across all days.
+func (s lifetimeStats) total(key string) int {
+	sum := 0
+	for _, day := range s {
+		sum += day[key]
+	}
+	return sum
+}
+
+// loadLifetimeStats reads the accumulated counters; a missing or broken
+// file is an empty history.
+func loadLifetimeStats() lifetimeStats {
+	data, err := os.ReadFile(statePath(lifetimeStatsFile))
+	if err != nil {
+		return lifetimeStats{}
+	}
+	var s lifetimeStats
+	if err := json.Unmarshal(data, &s); err != nil {
+		return lifetimeStats{}
+	}
+	return s
+}
+
+// bumpLifetimeStats folds the given counters into today's bucket. Called
+// once per finished run, not per item.
+func bumpLifetimeStats(counts map[string]int) {
+	s := loadLifetimeStats()
+	today := time.Now().Format("2006-01-02")
+	if s[today] == nil {
+		s[today] = make(map[string]int)
+	}
+	for key, n := range counts {
+		s[today][key] += n
+	}
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(lifetimeStatsFile), data); err != nil {
+		fmt.Printf("⚠️  Could not save lifetime stats: %v\n", err)
+	}
+}
+
+// printLifetimeStats renders the all-time block of the statistics screen.
+func printLifetimeStats() {
+	s := loadLifetimeStats()
+	if len(s) == 0 {
+		return
+	}
+	today := s[time.Now().Format("2006-01-02")]
+	fmt.Println("── All time ──")
+	fmt.Printf("Checked accounts: %d (today %d)\n", s.total("checker.checked"), today["checker.checked"])
+	fmt.Printf("Valid emails:     %d (today %d)\n", s.total("checker.valid"), today["checker.valid"])
+	fmt.Printf("Stores found:     %d (today %d)\n", s.total("finder.stores"), today["finder.stores"])
+	fmt.Printf("Search queries:   %d (today %d)\n", s.total("finder.queries"), today["finder.queries"])
+}
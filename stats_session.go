@@ -0,0 +1,37 @@
+package main
+
+import "sync"
+
+// CheckerStats counts a single email-checking run. Each run owns its own
+// instance, so two sessions (or a test) never race on shared counters;
+// the statistics screen reads session-wide totals from the aggregator.
+type CheckerStats struct {
+	Checked int
+	Valid   int
+}
+
+// sessionTotals folds the per-run stats of every module into the numbers
+// shown on the statistics screen and in message templates.
+type sessionTotals struct {
+	mu      sync.Mutex
+	checked int
+	valid   int
+}
+
+var session sessionTotals
+
+// addChecker merges a finished checker run into the session totals.
+func (t *sessionTotals) addChecker(s *CheckerStats) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.checked += s.Checked
+	t.valid += s.Valid
+}
+
+// checkerTotals reports how many emails were checked and found valid
+// across every run this session.
+func (t *sessionTotals) checkerTotals() (checked, valid int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.checked, t.valid
+}
@@ -0,0 +1,53 @@
+package main
+
+import "fmt"
+
+// stockSnapshot is one journal entry: the operation about to run and the
+// stock as it looked right before.
+type stockSnapshot struct {
+	op    string
+	stock []Crown
+}
+
+// stockJournal keeps the most recent snapshots for undo, newest last.
+// stockMu guards it alongside the stock itself, since journal entries are
+// written from the same goroutines that mutate stock.
+var stockJournal []stockSnapshot
+
+const stockJournalDepth = 20
+
+// journalStock records the current stock before a mutating operation.
+func journalStock(op string) {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	snapshot := stockSnapshot{op: op, stock: make([]Crown, len(stock))}
+	copy(snapshot.stock, stock)
+	stockJournal = append(stockJournal, snapshot)
+	if len(stockJournal) > stockJournalDepth {
+		stockJournal = stockJournal[1:]
+	}
+}
+
+// resetStockJournal drops all journal entries (workspace switches: an
+// undo must never restore another workspace's stock).
+func resetStockJournal() {
+	stockMu.Lock()
+	stockJournal = nil
+	stockMu.Unlock()
+}
+
+// undoLastStockOp restores the stock to its state before the last journaled
+// operation, through the store so the usual event fires.
+func undoLastStockOp() {
+	stockMu.Lock()
+	if len(stockJournal) == 0 {
+		stockMu.Unlock()
+		fmt.Println("❌ Nothing to undo")
+		return
+	}
+	last := stockJournal[len(stockJournal)-1]
+	stockJournal = stockJournal[:len(stockJournal)-1]
+	stockMu.Unlock()
+	store.Replace(last.stock)
+	fmt.Printf("↩️  Undid %q (stock back to %d crowns)\n", last.op, len(last.stock))
+}
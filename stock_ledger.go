@@ -0,0 +1,74 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+)
+
+// consumedLedgerFile persists every code ever dispensed or removed, so a
+// later import can't accidentally restock an already-consumed crown.
+const consumedLedgerFile = "consumed_codes.json"
+
+var (
+	consumedMu     sync.Mutex
+	consumedCodes  = make(map[string]bool)
+	consumedLoaded bool
+)
+
+// loadConsumedLedger reads the ledger from disk; missing file means an
+// empty ledger.
+func loadConsumedLedger() {
+	consumedMu.Lock()
+	defer consumedMu.Unlock()
+	consumedLoaded = true
+	data, err := os.ReadFile(statePath(consumedLedgerFile))
+	if err != nil {
+		return
+	}
+	var codes []string
+	if err := json.Unmarshal(data, &codes); err != nil {
+		fmt.Printf("⚠️  Invalid %s: %v (starting empty)\n", consumedLedgerFile, err)
+		return
+	}
+	for _, code := range codes {
+		consumedCodes[code] = true
+	}
+}
+
+// recordConsumed adds codes to the ledger and saves it.
+func recordConsumed(codes ...string) {
+	consumedMu.Lock()
+	defer consumedMu.Unlock()
+	changed := false
+	for _, code := range codes {
+		if code != "" && !consumedCodes[code] {
+			consumedCodes[code] = true
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	all := make([]string, 0, len(consumedCodes))
+	for code := range consumedCodes {
+		all = append(all, code)
+	}
+	sort.Strings(all)
+	data, err := json.MarshalIndent(all, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := writeFileAtomic(statePath(consumedLedgerFile), data); err != nil {
+		fmt.Printf("⚠️  Cannot save %s: %v\n", consumedLedgerFile, err)
+	}
+}
+
+// isConsumed reports whether the code was ever dispensed or removed.
+func isConsumed(code string) bool {
+	consumedMu.Lock()
+	defer consumedMu.Unlock()
+	return consumedCodes[code]
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// formatStockMessage renders crowns as a ready-to-post chat message:
+// per-type counts, monospaced codes, and each code wrapped in a spoiler
+// tag (||...||, understood by both Telegram MarkdownV2 and Discord).
+func formatStockMessage(crowns []Crown, platform string) string {
+	byType := make(map[string][]Crown)
+	for _, c := range crowns {
+		byType[c.Type] = append(byType[c.Type], c)
+	}
+	types := make([]string, 0, len(byType))
+	for t := range byType {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "👑 *Crown stock* — %d codes\n", len(crowns))
+	for _, t := range types {
+		group := byType[t]
+		fmt.Fprintf(&b, "\n*%s* — %d\n", escapeChatText(t, platform), len(group))
+		for _, c := range group {
+			fmt.Fprintf(&b, "||`%s`||\n", c.Code)
+		}
+	}
+	return b.String()
+}
+
+// escapeChatText escapes characters that would break formatting in the
+// target platform's markup (Telegram MarkdownV2 is the stricter of the two).
+func escapeChatText(s, platform string) string {
+	specials := "*_`|"
+	if platform == "telegram" {
+		specials = "_*[]()~`>#+-=|{}.!"
+	}
+	var b strings.Builder
+	for _, r := range s {
+		if strings.ContainsRune(specials, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// exportStockMessage renders the current stock (optionally one type,
+// unused codes only by default) as a chat message, saves it, and prints
+// it for copy-pasting.
+func exportStockMessage() {
+	if len(stock) == 0 {
+		fmt.Println("📦 Stock is empty")
+		return
+	}
+	platform := promptLine("Platform [telegram/discord] (default telegram): ")
+	if platform == "" {
+		platform = "telegram"
+	}
+	typeFilter := promptLine("Type filter (empty for all): ")
+	status := "unused"
+	if promptYes("Include used codes? [y/N]: ") {
+		status = ""
+	}
+
+	subset := filterStock(store.List(), "", typeFilter, "", status)
+	if len(subset) == 0 {
+		fmt.Println("📦 No crowns match")
+		return
+	}
+	message := formatStockMessage(subset, platform)
+	path := outputPath("stock_message", "txt")
+	if err := writeFileAtomic(path, []byte(message)); err != nil {
+		fmt.Printf("❌ Cannot create %s: %v\n", path, err)
+		return
+	}
+	fmt.Printf("💾 Saved %s message for %d crowns to %s\n\n", platform, len(subset), path)
+	fmt.Println(message)
+}
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"fmt"
+
+	crownstock "github.com/sushuhq-glitch/exe-decompiler-pro/internal/stock"
+)
+
+// codeRules validates codes against the configured per-type patterns. The
+// lookup hook reads the live config, so reloads take effect immediately.
+var codeRules = crownstock.NewRules(
+	func(crownType string) string { return config.CodeRules[crownType] },
+	func(crownType string, err error) {
+		fmt.Printf("⚠️  Invalid code rule for type %q: %v\n", crownType, err)
+	},
+)
+
+// validateCode checks a code against the configured rule for its type.
+func validateCode(crownType, code string) error {
+	return codeRules.Validate(crownType, code)
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"time"
+
+	crownstock "github.com/sushuhq-glitch/exe-decompiler-pro/internal/stock"
+)
+
+// StockStore is the single code path for stock mutations, shared by the
+// menu, auto restock, the Telegram bot and any future API. The interface
+// is defined in internal/stock.
+type StockStore = crownstock.Store
+
+// memoryStockStore guards the package stock slice with stockMu.
+type memoryStockStore struct{}
+
+// store is the process-wide stock store.
+var store StockStore = &memoryStockStore{}
+
+func (s *memoryStockStore) Add(crowns ...Crown) (added, skipped int) {
+	added, skipped = s.add(crowns)
+	if added > 0 {
+		// Published outside stockMu: subscribers read the stock themselves.
+		emitEvent(evStockChanged, map[string]interface{}{"op": "add", "count": added})
+	}
+	return added, skipped
+}
+
+func (s *memoryStockStore) add(crowns []Crown) (added, skipped int) {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	existing := make(map[string]bool, len(stock))
+	for _, c := range stock {
+		existing[c.Code] = true
+	}
+	for _, c := range crowns {
+		if c.Code == "" || existing[c.Code] {
+			skipped++
+			continue
+		}
+		if config.RejectConsumedImports && isConsumed(c.Code) {
+			skipped++
+			continue
+		}
+		existing[c.Code] = true
+		stock = append(stock, c)
+		added++
+	}
+	return added, skipped
+}
+
+func (s *memoryStockStore) List() []Crown {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	out := make([]Crown, len(stock))
+	copy(out, stock)
+	return out
+}
+
+func (s *memoryStockStore) Remove(code string) bool {
+	removed := s.remove(code)
+	if removed {
+		emitEvent(evStockChanged, map[string]interface{}{"op": "remove"})
+	}
+	return removed
+}
+
+func (s *memoryStockStore) remove(code string) bool {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	for i, c := range stock {
+		if c.Code == code {
+			stock = append(stock[:i], stock[i+1:]...)
+			recordConsumed(code)
+			return true
+		}
+	}
+	return false
+}
+
+func (s *memoryStockStore) Dispense(pool string) (Crown, error) {
+	c, err := dispenseLocked(pool)
+	if err == nil {
+		emitEvent(evStockChanged, map[string]interface{}{"op": "dispense", "pool": pool})
+	}
+	return c, err
+}
+
+func (s *memoryStockStore) MarkUsed(code, note string) (Crown, error) {
+	c, err := s.markUsed(code, note)
+	if err == nil {
+		emitEvent(evStockChanged, map[string]interface{}{"op": "dispense", "pool": c.Pool})
+	}
+	return c, err
+}
+
+func (s *memoryStockStore) markUsed(code, note string) (Crown, error) {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	for i := range stock {
+		if stock[i].Code != code {
+			continue
+		}
+		if stock[i].Used {
+			return Crown{}, crownstock.ErrAlreadyUsed
+		}
+		now := time.Now()
+		stock[i].Used = true
+		stock[i].UsedAt = &now
+		stock[i].UsedNote = note
+		recordConsumed(code)
+		return stock[i], nil
+	}
+	return Crown{}, crownstock.ErrNotInStock
+}
+
+func (s *memoryStockStore) Replace(crowns []Crown) {
+	stockMu.Lock()
+	stock = make([]Crown, len(crowns))
+	copy(stock, crowns)
+	stockMu.Unlock()
+	emitEvent(evStockChanged, map[string]interface{}{"op": "replace", "count": len(crowns)})
+}
+
+func (s *memoryStockStore) Count() int {
+	stockMu.Lock()
+	defer stockMu.Unlock()
+	return len(stock)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	crownstock "github.com/sushuhq-glitch/exe-decompiler-pro/internal/stock"
+)
+
+// resetStockState gives a test a clean stock, journal and ledger, with
+// state files pointed at a throwaway directory.
+func resetStockState(t *testing.T) {
+	t.Helper()
+	t.Setenv("XDG_DATA_HOME", t.TempDir())
+	stockMu.Lock()
+	stock = nil
+	expiredStock = nil
+	stockJournal = nil
+	stockMu.Unlock()
+	consumedMu.Lock()
+	consumedCodes = make(map[string]bool)
+	consumedMu.Unlock()
+}
+
+func TestStoreAddSkipsDuplicatesAndEmptyCodes(t *testing.T) {
+	resetStockState(t)
+	added, skipped := store.Add(
+		Crown{Code: "AAA-111", Type: "generic", AddedAt: time.Now()},
+		Crown{Code: "BBB-222", Type: "generic", AddedAt: time.Now()},
+		Crown{Code: "AAA-111", Type: "generic", AddedAt: time.Now()},
+		Crown{Code: "", Type: "generic", AddedAt: time.Now()},
+	)
+	if added != 2 || skipped != 2 {
+		t.Fatalf("Add = (%d added, %d skipped), want (2, 2)", added, skipped)
+	}
+	if n := store.Count(); n != 2 {
+		t.Fatalf("Count = %d, want 2", n)
+	}
+	// A second Add of an existing code is a duplicate again.
+	if added, skipped = store.Add(Crown{Code: "BBB-222"}); added != 0 || skipped != 1 {
+		t.Fatalf("re-Add = (%d added, %d skipped), want (0, 1)", added, skipped)
+	}
+}
+
+func TestStoreDispensePicksOldestUnusedInPool(t *testing.T) {
+	resetStockState(t)
+	now := time.Now()
+	store.Add(
+		Crown{Code: "NEW-001", AddedAt: now},
+		Crown{Code: "OLD-001", AddedAt: now.Add(-time.Hour)},
+		Crown{Code: "VIP-001", Pool: "vip", AddedAt: now.Add(-2 * time.Hour)},
+	)
+
+	c, err := store.Dispense("")
+	if err != nil {
+		t.Fatalf("Dispense: %v", err)
+	}
+	if c.Code != "OLD-001" {
+		t.Fatalf("Dispense = %s, want the oldest default-pool crown OLD-001", c.Code)
+	}
+	if !c.Used || c.UsedAt == nil {
+		t.Fatalf("dispensed crown not marked used: %+v", c)
+	}
+	if !isConsumed("OLD-001") {
+		t.Error("dispensed code missing from the consumed ledger")
+	}
+
+	if _, err := store.Dispense("vip"); err != nil {
+		t.Fatalf("Dispense(vip): %v", err)
+	}
+	if _, err := store.Dispense("vip"); !errors.Is(err, errPoolEmpty) {
+		t.Fatalf("Dispense on drained pool = %v, want errPoolEmpty", err)
+	}
+}
+
+func TestStoreMarkUsed(t *testing.T) {
+	resetStockState(t)
+	store.Add(Crown{Code: "CCC-333", AddedAt: time.Now()})
+
+	c, err := store.MarkUsed("CCC-333", "for alice")
+	if err != nil {
+		t.Fatalf("MarkUsed: %v", err)
+	}
+	if !c.Used || c.UsedNote != "for alice" {
+		t.Fatalf("MarkUsed crown = %+v, want used with the note", c)
+	}
+	if _, err := store.MarkUsed("CCC-333", ""); !errors.Is(err, crownstock.ErrAlreadyUsed) {
+		t.Fatalf("second MarkUsed = %v, want ErrAlreadyUsed", err)
+	}
+	if _, err := store.MarkUsed("NOPE-000", ""); !errors.Is(err, crownstock.ErrNotInStock) {
+		t.Fatalf("MarkUsed on unknown code = %v, want ErrNotInStock", err)
+	}
+}
+
+func TestStoreRemoveAndReplace(t *testing.T) {
+	resetStockState(t)
+	store.Add(
+		Crown{Code: "DDD-444", AddedAt: time.Now()},
+		Crown{Code: "EEE-555", AddedAt: time.Now()},
+	)
+	if !store.Remove("DDD-444") {
+		t.Fatal("Remove of a stocked code returned false")
+	}
+	if store.Remove("DDD-444") {
+		t.Fatal("Remove of an already-removed code returned true")
+	}
+
+	replacement := []Crown{{Code: "FFF-666", AddedAt: time.Now()}}
+	store.Replace(replacement)
+	if n := store.Count(); n != 1 {
+		t.Fatalf("Count after Replace = %d, want 1", n)
+	}
+	// Replace must copy: mutating the caller's slice may not reach the stock.
+	replacement[0].Code = "MUTATED"
+	if got := store.List()[0].Code; got != "FFF-666" {
+		t.Fatalf("stock code after caller mutation = %q, want FFF-666", got)
+	}
+}
+
+func TestJournalCapsDepthAndUndoRestores(t *testing.T) {
+	resetStockState(t)
+	store.Add(Crown{Code: "GGG-777", AddedAt: time.Now()})
+
+	journalStock("clear all stock")
+	store.Replace(nil)
+	if n := store.Count(); n != 0 {
+		t.Fatalf("Count after clear = %d, want 0", n)
+	}
+
+	undoLastStockOp()
+	if n := store.Count(); n != 1 {
+		t.Fatalf("Count after undo = %d, want 1", n)
+	}
+	if got := store.List()[0].Code; got != "GGG-777" {
+		t.Fatalf("restored code = %q, want GGG-777", got)
+	}
+
+	for i := 0; i < stockJournalDepth+5; i++ {
+		journalStock(fmt.Sprintf("op %d", i))
+	}
+	stockMu.Lock()
+	depth := len(stockJournal)
+	oldest := stockJournal[0].op
+	stockMu.Unlock()
+	if depth != stockJournalDepth {
+		t.Fatalf("journal depth = %d, want %d", depth, stockJournalDepth)
+	}
+	if oldest != "op 5" {
+		t.Fatalf("oldest journal entry = %q, want the early ones dropped (op 5)", oldest)
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/storedb"
+)
+
+// storeDBFile is the single database every finder run lands in; the
+// timestamped export files remain views derived from it.
+const storeDBFile = "crownpal.db"
+
+func storeDBPath() string {
+	return statePath(storeDBFile)
+}
+
+// persistRunDB upserts a finished run into the store database. Failures
+// only warn: the run's in-memory results and exports are unaffected.
+func persistRunDB(mode string, stats *SearchStats, results []StoreResult) {
+	if len(results) == 0 {
+		return
+	}
+	db, err := storedb.Open(storeDBPath())
+	if err != nil {
+		fmt.Printf("⚠️  Store database: %v\n", err)
+		return
+	}
+	defer db.Close()
+	runID, err := db.RecordRun(mode, stats.StartTime, time.Since(stats.StartTime), stats.Errors, results)
+	if err != nil {
+		fmt.Printf("⚠️  Store database: %v\n", err)
+		return
+	}
+	fmt.Printf("💾 Run #%d recorded in %s\n", runID, storeDBPath())
+}
+
+func init() {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Query and export from the store database",
+	}
+
+	var runsLimit int
+	dbRunsCmd := &cobra.Command{
+		Use:   "runs",
+		Short: "List runs recorded in the store database",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := storedb.Open(storeDBPath())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			runs, err := db.Runs(runsLimit)
+			if err != nil {
+				return err
+			}
+			if len(runs) == 0 {
+				fmt.Println("📦 No runs recorded yet")
+				return nil
+			}
+			for _, r := range runs {
+				fmt.Printf("#%-4d %s %-8s %8s stores=%d errors=%d\n",
+					r.ID, r.Started.Format("2006-01-02 15:04"), r.Mode, r.Elapsed, r.Stores, r.Errors)
+			}
+			return nil
+		},
+	}
+	dbRunsCmd.Flags().IntVar(&runsLimit, "limit", 20, "most recent runs to list (0 = all)")
+
+	var exportRun int64
+	var exportFormat string
+	dbExportCmd := &cobra.Command{
+		Use:   "export",
+		Short: "Export a run from the store database in any format",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			db, err := storedb.Open(storeDBPath())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			results, err := db.Results(exportRun)
+			if err != nil {
+				return err
+			}
+			if exportResults(results, exportFormat) == "" {
+				return fmt.Errorf("export failed")
+			}
+			return nil
+		},
+	}
+	dbExportCmd.Flags().Int64Var(&exportRun, "run", 0, "run id to export (0 = latest)")
+	dbExportCmd.Flags().StringVar(&exportFormat, "format", "json", "export format")
+
+	var aliasCanonical string
+	dbAliasCmd := &cobra.Command{
+		Use:   "alias <url>",
+		Short: "Mark a URL as an alias of an existing store",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if aliasCanonical == "" {
+				return fmt.Errorf("--of is required")
+			}
+			db, err := storedb.Open(storeDBPath())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			if err := db.AddAlias(args[0], aliasCanonical); err != nil {
+				return err
+			}
+			fmt.Printf("✅ %s now counts as %s\n", args[0], aliasCanonical)
+			return nil
+		},
+	}
+	dbAliasCmd.Flags().StringVar(&aliasCanonical, "of", "", "canonical store URL the alias maps to")
+
+	dbCmd.AddCommand(dbRunsCmd, dbExportCmd, dbAliasCmd)
+	rootCmd.AddCommand(dbCmd)
+}
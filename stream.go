@@ -0,0 +1,71 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+)
+
+// streamBufferLines bounds the channel between the reader goroutine and
+// its consumer, so a fast disk cannot pull a million-line list into
+// memory ahead of a slow consumer.
+const streamBufferLines = 1024
+
+// streamProgressEvery is how many processed lines pass between progress
+// reports on a streamed list.
+const streamProgressEvery = 25000
+
+// streamProgress counts bytes consumed from a streamed list; total stays
+// zero when the size is unknown (stdin).
+type streamProgress struct {
+	read  atomic.Int64
+	total int64
+}
+
+func (p *streamProgress) String() string {
+	read := p.read.Load()
+	if p.total > 0 {
+		return fmt.Sprintf("%.1f/%.1f MB (%d%%)",
+			float64(read)/1e6, float64(p.total)/1e6, read*100/p.total)
+	}
+	return fmt.Sprintf("%.1f MB", float64(read)/1e6)
+}
+
+// streamLines opens a list input ("-" for stdin) and feeds its non-empty
+// trimmed lines through a bounded channel, so arbitrarily large lists
+// never sit in memory at once. The returned func reports any read error
+// once the channel is closed. The reader stops when appCtx is cancelled.
+func streamLines(path string) (<-chan string, *streamProgress, func() error, error) {
+	f, err := openListInput(path)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	progress := &streamProgress{}
+	if info, err := os.Stat(path); path != "-" && err == nil {
+		progress.total = info.Size()
+	}
+	lines := make(chan string, streamBufferLines)
+	var readErr error
+	go func() {
+		defer close(lines)
+		defer f.Close()
+		scanner := bufio.NewScanner(f)
+		scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			progress.read.Add(int64(len(scanner.Bytes()) + 1))
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" {
+				continue
+			}
+			select {
+			case lines <- line:
+			case <-appCtx.Done():
+				return
+			}
+		}
+		readErr = scanner.Err()
+	}()
+	return lines, progress, func() error { return readErr }, nil
+}
@@ -0,0 +1,149 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"html"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/notify"
+)
+
+func telegramMode() {
+	clearScreen()
+	fmt.Println("═══════════ TELEGRAM CHANNEL ═══════════")
+	if config.TelegramToken == "" || channelFor("general") == "" {
+		fmt.Println("⚠️  Set telegram_token and a channel in config.json first")
+		pause()
+		return
+	}
+	fmt.Println("Write the message to post (empty line to finish).")
+	fmt.Println("Placeholders like {stock_count}, {pool.default}, {date}, {stats.valid_emails} are filled in before sending.")
+	scanner := bufio.NewScanner(os.Stdin)
+	var lines []string
+	for scanner.Scan() {
+		line := scanner.Text()
+		if line == "" {
+			break
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) == 0 {
+		return
+	}
+	message := renderMessageTemplate(strings.Join(lines, "\n"))
+	fmt.Printf("\n── Preview ──\n%s\n─────────────\n", message)
+	if strings.EqualFold(promptLine("Send? [Y/n]: "), "n") {
+		return
+	}
+	writeOnChannel(message)
+	pause()
+}
+
+// telegramAPIBase is a variable so tests can point sends at a fake server.
+var telegramAPIBase = notify.DefaultTelegramAPIBase
+
+// telegramClient builds a notify.Telegram from the live config, wired to
+// the outbox pacing, dry-run gating and the app logger.
+func telegramClient(timeout time.Duration) *notify.Telegram {
+	return &notify.Telegram{
+		Token:     config.TelegramToken,
+		ParseMode: config.TelegramParseMode,
+		APIBase:   telegramAPIBase,
+		Client:    newHTTPClient(timeout),
+		Wait:      outbox.wait,
+		Backoff:   outbox.backoff,
+		DryRun:    dryRunSkip,
+		Logger:    logger,
+	}
+}
+
+// escapeTelegram escapes user-provided content (codes, store names,
+// paths) for the configured parse mode, so underscores or angle brackets
+// in it don't make sends fail or render broken.
+func escapeTelegram(s string) string {
+	switch config.TelegramParseMode {
+	case "MarkdownV2":
+		return escapeChatText(s, "telegram")
+	case "HTML":
+		return html.EscapeString(s)
+	}
+	return s
+}
+
+// channelFor resolves the chat ID that should receive an event kind
+// ("stock", "search", "export", "general"): routes pick a named channel,
+// then the "default" channel, then the legacy channel_id.
+func channelFor(kind string) string {
+	if name, ok := config.Routes[kind]; ok {
+		if id, ok := config.Channels[name]; ok {
+			return id
+		}
+		fmt.Printf("⚠️  Route %q points at unknown channel %q\n", kind, name)
+	}
+	if id, ok := config.Channels["default"]; ok {
+		return id
+	}
+	return config.ChannelID
+}
+
+// notifyChannel posts a message to whichever channel the event kind is
+// routed to.
+func notifyChannel(kind, message string) {
+	notifyPlugins(kind, message)
+	if err := sendTelegramMessage(channelFor(kind), message); err != nil {
+		fmt.Printf("❌ Telegram: %v\n", err)
+		return
+	}
+	fmt.Println("✅ Message sent to channel")
+}
+
+// writeOnChannel posts a general message to the configured channel.
+func writeOnChannel(message string) {
+	notifyChannel("general", message)
+}
+
+// sendTelegramMessage calls the Bot API sendMessage method, retrying rate
+// limit responses using the retry_after hint Telegram returns.
+func sendTelegramMessage(chatID, text string) error {
+	if config.TelegramToken == "" || chatID == "" {
+		return fmt.Errorf("set telegram_token and channel_id in %s first", configPath())
+	}
+	return telegramClient(30*time.Second).SendMessage(appCtx, chatID, text)
+}
+
+// sendTelegramDocument uploads a file to the channel via the Bot API
+// sendDocument method, with the same rate-limit retry as text sends.
+func sendTelegramDocument(chatID, path, caption string) error {
+	if config.TelegramToken == "" || chatID == "" {
+		return fmt.Errorf("set telegram_token and channel_id in %s first", configPath())
+	}
+	return telegramClient(2*time.Minute).SendDocument(appCtx, chatID, path, caption)
+}
+
+// offerChannelUpload asks whether to post the just-written export files to
+// the channel; it does nothing when Telegram isn't configured.
+func offerChannelUpload(files []string) {
+	chatID := channelFor("export")
+	if len(files) == 0 || config.TelegramToken == "" || chatID == "" {
+		return
+	}
+	if headless {
+		if !config.AutoChannelUpload {
+			return
+		}
+	} else {
+		if !promptYes("Send export files to the Telegram channel? [y/N]: ") {
+			return
+		}
+	}
+	for _, file := range files {
+		if err := sendTelegramDocument(chatID, file, ""); err != nil {
+			fmt.Printf("❌ Telegram upload of %s: %v\n", file, err)
+			continue
+		}
+		fmt.Printf("✅ Sent %s to channel\n", file)
+	}
+}
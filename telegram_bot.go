@@ -0,0 +1,268 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/notify"
+)
+
+// tgUpdate is the slice of the Bot API update payload the stock bot needs.
+type tgUpdate struct {
+	UpdateID int64 `json:"update_id"`
+	Message  *struct {
+		Chat struct {
+			ID int64 `json:"id"`
+		} `json:"chat"`
+		From struct {
+			ID int64 `json:"id"`
+		} `json:"from"`
+		Text     string `json:"text"`
+		Document *struct {
+			FileID   string `json:"file_id"`
+			FileName string `json:"file_name"`
+		} `json:"document"`
+	} `json:"message"`
+}
+
+var (
+	stockBotMu sync.Mutex
+	stockBotOn bool
+	// stockBotPools remembers the /pool choice per chat.
+	stockBotPools = make(map[int64]string)
+)
+
+// startStockBot long-polls the Bot API so trusted users can DM code lists
+// or files that get validated, deduped and imported into stock.
+func startStockBot() error {
+	stockBotMu.Lock()
+	defer stockBotMu.Unlock()
+	if stockBotOn {
+		return fmt.Errorf("stock bot already running")
+	}
+	if config.TelegramToken == "" {
+		return fmt.Errorf("set telegram_token in %s first", configPath())
+	}
+	if len(config.TrustedUserIDs) == 0 {
+		return fmt.Errorf("set trusted_user_ids in %s first", configPath())
+	}
+	stockBotOn = true
+	go stockBotLoop()
+	return nil
+}
+
+func stockBotLoop() {
+	client := newHTTPClient(40 * time.Second)
+	offset := int64(0)
+	for {
+		updates, err := fetchTelegramUpdates(client, offset)
+		if err != nil {
+			time.Sleep(5 * time.Second)
+			continue
+		}
+		for _, u := range updates {
+			offset = u.UpdateID + 1
+			handleStockSubmission(client, u)
+		}
+	}
+}
+
+// fetchTelegramUpdates long-polls getUpdates starting at offset.
+func fetchTelegramUpdates(client *http.Client, offset int64) ([]tgUpdate, error) {
+	endpoint := fmt.Sprintf("%s/bot%s/getUpdates", telegramAPIBase, config.TelegramToken)
+	form := url.Values{
+		"offset":  {fmt.Sprintf("%d", offset)},
+		"timeout": {"30"},
+	}
+	req, err := http.NewRequestWithContext(appCtx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 8*1024*1024))
+	if err != nil {
+		return nil, err
+	}
+	var reply struct {
+		OK     bool       `json:"ok"`
+		Result []tgUpdate `json:"result"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return nil, err
+	}
+	if !reply.OK {
+		return nil, fmt.Errorf("getUpdates: %s", notify.ErrorDescription(body))
+	}
+	return reply.Result, nil
+}
+
+// trustedUser reports whether the sender may submit stock.
+func trustedUser(id int64) bool {
+	for _, trusted := range config.TrustedUserIDs {
+		if trusted == id {
+			return true
+		}
+	}
+	return false
+}
+
+// handleStockSubmission imports a DM'd code list or file and replies with
+// the import summary. Messages from unknown users are ignored.
+func handleStockSubmission(client *http.Client, u tgUpdate) {
+	msg := u.Message
+	if msg == nil || !trustedUser(msg.From.ID) {
+		return
+	}
+	chatID := fmt.Sprintf("%d", msg.Chat.ID)
+
+	if strings.HasPrefix(msg.Text, "/jobs") {
+		jobs := jobSnapshot()
+		if len(jobs) == 0 {
+			sendTelegramMessage(chatID, "📦 No jobs recorded")
+			return
+		}
+		if len(jobs) > 10 {
+			jobs = jobs[len(jobs)-10:]
+		}
+		var lines []string
+		for _, j := range jobs {
+			lines = append(lines, escapeTelegram(j.describe()))
+		}
+		sendTelegramMessage(chatID, strings.Join(lines, "\n"))
+		return
+	}
+	if strings.HasPrefix(msg.Text, "/job") {
+		handleJobCommand(chatID, msg.Text)
+		return
+	}
+	if strings.HasPrefix(msg.Text, "/pool") {
+		pool := strings.TrimSpace(strings.TrimPrefix(msg.Text, "/pool"))
+		stockBotPools[msg.Chat.ID] = pool
+		label := pool
+		if label == "" {
+			label = "default"
+		}
+		sendTelegramMessage(chatID, "📦 Imports from you now go to pool: "+escapeTelegram(label))
+		return
+	}
+
+	var name string
+	var data []byte
+	switch {
+	case msg.Document != nil:
+		var err error
+		data, err = downloadTelegramFile(client, msg.Document.FileID)
+		if err != nil {
+			sendTelegramMessage(chatID, "❌ Could not fetch file: "+escapeTelegram(err.Error()))
+			return
+		}
+		name = msg.Document.FileName
+	case strings.TrimSpace(msg.Text) != "":
+		data = []byte(msg.Text)
+		name = "message.txt"
+	default:
+		return
+	}
+
+	batch, invalid, err := parseStockCrowns(name, data)
+	if err != nil {
+		sendTelegramMessage(chatID, "❌ Invalid stock file: "+escapeTelegram(err.Error()))
+		return
+	}
+	pool := stockBotPools[msg.Chat.ID]
+	for i := range batch {
+		batch[i].Pool = pool
+	}
+	journalStock(fmt.Sprintf("telegram import from user %d", msg.From.ID))
+	added, skipped := store.Add(batch...)
+	sendTelegramMessage(chatID, fmt.Sprintf(
+		"✅ Imported %d codes (%d duplicates, %d invalid, total %d)",
+		added, skipped, invalid, store.Count()))
+}
+
+// handleJobCommand queues a daemon job from a "/job <kind> key=value ..."
+// message and replies with the job id or the usage help.
+func handleJobCommand(chatID, text string) {
+	fields := strings.Fields(text)[1:]
+	if len(fields) == 0 {
+		var lines []string
+		lines = append(lines, "Usage: /job <kind> key=value ...")
+		for kind, help := range jobKinds {
+			lines = append(lines, fmt.Sprintf("%s — %s", kind, help))
+		}
+		sendTelegramMessage(chatID, escapeTelegram(strings.Join(lines, "\n")))
+		return
+	}
+	params := make(map[string]string)
+	for _, kv := range fields[1:] {
+		key, value, ok := strings.Cut(kv, "=")
+		if !ok {
+			sendTelegramMessage(chatID, "❌ Parameters are key=value, got "+escapeTelegram(kv))
+			return
+		}
+		params[key] = value
+	}
+	job, err := enqueueJob(fields[0], params)
+	if err != nil {
+		sendTelegramMessage(chatID, "❌ "+escapeTelegram(err.Error()))
+		return
+	}
+	sendTelegramMessage(chatID, fmt.Sprintf("✅ Queued job #%d (%s)", job.ID, escapeTelegram(job.Kind)))
+}
+
+// downloadTelegramFile resolves a file_id via getFile and downloads it.
+func downloadTelegramFile(client *http.Client, fileID string) ([]byte, error) {
+	endpoint := fmt.Sprintf("%s/bot%s/getFile", telegramAPIBase, config.TelegramToken)
+	form := url.Values{"file_id": {fileID}}
+	req, err := http.NewRequestWithContext(appCtx, "POST", endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	var reply struct {
+		OK     bool `json:"ok"`
+		Result struct {
+			FilePath string `json:"file_path"`
+		} `json:"result"`
+	}
+	if err := json.Unmarshal(body, &reply); err != nil {
+		return nil, err
+	}
+	if !reply.OK || reply.Result.FilePath == "" {
+		return nil, fmt.Errorf("getFile: %s", notify.ErrorDescription(body))
+	}
+	fileURL := fmt.Sprintf("%s/file/bot%s/%s", telegramAPIBase, config.TelegramToken, reply.Result.FilePath)
+	fileReq, err := http.NewRequestWithContext(appCtx, "GET", fileURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	fileResp, err := client.Do(fileReq)
+	if err != nil {
+		return nil, err
+	}
+	defer fileResp.Body.Close()
+	if fileResp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("status %d downloading file", fileResp.StatusCode)
+	}
+	return io.ReadAll(io.LimitReader(fileResp.Body, 32*1024*1024))
+}
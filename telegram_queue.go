@@ -0,0 +1,43 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// telegramSendInterval is the minimum gap between outbound Bot API calls;
+// Telegram allows roughly one message per second per chat.
+const telegramSendInterval = time.Second
+
+// telegramPacer hands out send slots in FIFO order so bulk notification
+// bursts (restock summaries, export uploads during big runs) queue up
+// instead of tripping the flood limit and losing messages.
+type telegramPacer struct {
+	mu   sync.Mutex
+	next time.Time
+}
+
+var outbox telegramPacer
+
+// wait blocks until this send's slot comes up.
+func (p *telegramPacer) wait() {
+	p.mu.Lock()
+	now := time.Now()
+	slot := p.next
+	if slot.Before(now) {
+		slot = now
+	}
+	p.next = slot.Add(telegramSendInterval)
+	p.mu.Unlock()
+	time.Sleep(time.Until(slot))
+}
+
+// backoff pushes the next slot out after a 429, so queued senders also
+// honor the retry_after Telegram asked for.
+func (p *telegramPacer) backoff(d time.Duration) {
+	p.mu.Lock()
+	if until := time.Now().Add(d); p.next.Before(until) {
+		p.next = until
+	}
+	p.mu.Unlock()
+}
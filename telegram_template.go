@@ -0,0 +1,50 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+)
+
+// messagePlaceholderRe matches {name} placeholders in channel messages.
+var messagePlaceholderRe = regexp.MustCompile(`\{([a-z0-9_.-]+)\}`)
+
+// messageVars builds the live values channel message templates can use:
+// {stock_count}, {pool.NAME}, {date}, {time} and {stats.*} counters.
+func messageVars() map[string]string {
+	checked, valid := session.checkerTotals()
+	vars := map[string]string{
+		"stock_count":            fmt.Sprintf("%d", store.Count()),
+		"date":                   time.Now().Format("2006-01-02"),
+		"time":                   time.Now().Format("15:04"),
+		"stats.checked_accounts": fmt.Sprintf("%d", checked),
+		"stats.valid_emails":     fmt.Sprintf("%d", valid),
+	}
+	pools := make(map[string]int)
+	for _, c := range store.List() {
+		if !c.Used {
+			pools[c.Pool]++
+		}
+	}
+	for pool, n := range pools {
+		label := pool
+		if label == "" {
+			label = "default"
+		}
+		vars["pool."+label] = fmt.Sprintf("%d", n)
+	}
+	return vars
+}
+
+// renderMessageTemplate expands {placeholder} occurrences against the
+// live values; unknown placeholders are left as typed.
+func renderMessageTemplate(message string) string {
+	vars := messageVars()
+	return messagePlaceholderRe.ReplaceAllStringFunc(message, func(m string) string {
+		name := m[1 : len(m)-1]
+		if v, ok := vars[name]; ok {
+			return v
+		}
+		return m
+	})
+}
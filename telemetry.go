@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+)
+
+// Telemetry instruments; nil until initTelemetry wires an OTLP endpoint,
+// and every recording helper tolerates that.
+var (
+	otelQueries metric.Int64Counter
+	otelStores  metric.Int64Counter
+	otelErrors  metric.Int64Counter
+
+	// shutdownTelemetry flushes pending spans and metrics on exit.
+	shutdownTelemetry = func() {}
+)
+
+// initTelemetry wires OTLP metric and trace export when an endpoint is
+// configured, so scheduled runs show up in existing telemetry stacks.
+func initTelemetry() {
+	if config.OTLPEndpoint == "" {
+		return
+	}
+	ctx := context.Background()
+	res, err := resource.Merge(resource.Default(),
+		resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName("crownpal")))
+	if err != nil {
+		fmt.Printf("⚠️  Telemetry resource: %v\n", err)
+		return
+	}
+
+	traceExp, err := otlptracehttp.New(ctx,
+		otlptracehttp.WithEndpoint(config.OTLPEndpoint), otlptracehttp.WithInsecure())
+	if err != nil {
+		fmt.Printf("⚠️  OTLP trace exporter: %v\n", err)
+		return
+	}
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(traceExp),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	metricExp, err := otlpmetrichttp.New(ctx,
+		otlpmetrichttp.WithEndpoint(config.OTLPEndpoint), otlpmetrichttp.WithInsecure())
+	if err != nil {
+		fmt.Printf("⚠️  OTLP metric exporter: %v\n", err)
+		return
+	}
+	mp := sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(metricExp,
+			sdkmetric.WithInterval(15*time.Second))),
+		sdkmetric.WithResource(res),
+	)
+	otel.SetMeterProvider(mp)
+
+	meter := otel.Meter("crownpal")
+	otelQueries, _ = meter.Int64Counter("crownpal.queries")
+	otelStores, _ = meter.Int64Counter("crownpal.stores_found")
+	otelErrors, _ = meter.Int64Counter("crownpal.errors")
+
+	shutdownTelemetry = func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		tp.Shutdown(ctx)
+		mp.Shutdown(ctx)
+	}
+	logger.Info("telemetry enabled", "endpoint", config.OTLPEndpoint)
+}
+
+// countQuery records one provider query on the metrics side.
+func countQuery(provider string) {
+	if otelQueries != nil {
+		otelQueries.Add(context.Background(), 1,
+			metric.WithAttributes(attribute.String("provider", provider)))
+	}
+}
+
+// countStores records how many stores a finished run produced.
+func countStores(n int) {
+	if otelStores != nil {
+		otelStores.Add(context.Background(), int64(n))
+	}
+}
+
+// countError records n failures of the given class.
+func countError(class string, n int) {
+	if otelErrors != nil {
+		otelErrors.Add(context.Background(), int64(n),
+			metric.WithAttributes(attribute.String("class", class)))
+	}
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+var (
+	tuiTitleStyle    = lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("#dc143c")).Padding(0, 1)
+	tuiSelectedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("#dc143c")).Bold(true)
+	tuiItemStyle     = lipgloss.NewStyle().PaddingLeft(2)
+	tuiStatusStyle   = lipgloss.NewStyle().Foreground(lipgloss.Color("#888888")).MarginTop(1)
+)
+
+// menuItem is one selectable entry in the TUI main menu.
+type menuItem struct {
+	label string
+	run   func()
+}
+
+// menuModel is the Bubble Tea model for the main menu: arrow-key
+// navigation plus a status pane that refreshes once a second without
+// redrawing the whole screen.
+type menuModel struct {
+	items  []menuItem
+	cursor int
+	chosen *menuItem
+}
+
+// tickMsg drives the periodic status pane refresh.
+type tickMsg time.Time
+
+func menuTick() tea.Cmd {
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg { return tickMsg(t) })
+}
+
+func (m menuModel) Init() tea.Cmd { return menuTick() }
+
+func (m menuModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tickMsg:
+		return m, menuTick()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "up", "k":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "down", "j":
+			if m.cursor < len(m.items)-1 {
+				m.cursor++
+			}
+		case "enter":
+			m.chosen = &m.items[m.cursor]
+			return m, tea.Quit
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		}
+	}
+	return m, nil
+}
+
+func (m menuModel) View() string {
+	s := tuiTitleStyle.Render(tr("menu.title")) + "\n\n"
+	for i, item := range m.items {
+		if i == m.cursor {
+			s += tuiSelectedStyle.Render("› "+item.label) + "\n"
+		} else {
+			s += tuiItemStyle.Render(item.label) + "\n"
+		}
+	}
+	s += tuiStatusStyle.Render(menuStatus())
+	s += "\n\n" + tr("menu.keys") + "\n"
+	return s
+}
+
+// menuStatus is the live status pane under the menu.
+func menuStatus() string {
+	checked, valid := session.checkerTotals()
+	status := fmt.Sprintf("Stock: %d crowns (%d unused) · Checked: %d · Valid: %d",
+		store.Count(), countUnused(), checked, valid)
+	if activeWorkspace != "" {
+		status += " · Workspace: " + activeWorkspace
+	}
+	if line := restock.statusLine(); line != "" {
+		status += "\n" + line
+	}
+	return status
+}
+
+// runTUIMenu shows the main menu until the user quits; the selected mode
+// runs with the plain terminal since the flows are prompt-based.
+func runTUIMenu() {
+	items := []menuItem{
+		{tr("menu.finder"), cdKeyFinderMode},
+		{tr("menu.checker"), emailCheckerMode},
+		{tr("menu.stock"), crownStockManagement},
+		{tr("menu.telegram"), telegramMode},
+		{tr("menu.stats"), viewStatistics},
+		{tr("menu.query"), queryResultsMenu},
+		{tr("menu.compare"), compareResultsMenu},
+		{tr("menu.import"), importStoresMenu},
+		{tr("menu.profile"), switchProfileMenu},
+		{tr("menu.history"), sessionHistoryMenu},
+		{tr("menu.repeat"), repeatLastRun},
+		{tr("menu.runs"), runHistoryMenu},
+	}
+	for {
+		p := tea.NewProgram(menuModel{items: items})
+		final, err := p.Run()
+		if err != nil {
+			// Fall back to the plain menu on terminals Bubble Tea rejects.
+			mainMenu()
+			return
+		}
+		chosen := final.(menuModel).chosen
+		if chosen == nil {
+			fmt.Println(tr("msg.bye"))
+			return
+		}
+		clearScreen()
+		chosen.run()
+		if interrupted() {
+			fmt.Println(tr("msg.bye"))
+			return
+		}
+	}
+}
+
+// switchProfileMenu wraps profile and workspace switching in the prompts
+// the plain menu used.
+func switchProfileMenu() {
+	name := promptLine("Profile name (empty for default): ")
+	switchProfile(name)
+	if names := listWorkspaces(); len(names) > 0 {
+		fmt.Printf("Existing workspaces: %s\n", strings.Join(names, ", "))
+	}
+	ws := promptLine("Workspace (empty for default, new names are created): ")
+	switchWorkspace(ws)
+	pause()
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sushuhq-glitch/exe-decompiler-pro/internal/storage"
+)
+
+// UploadConfig routes finished artifacts (exports, backups) to storage
+// backends and/or a webhook URL. Everything is optional; nothing happens
+// when unset. The top-level S3 fields predate Destinations and keep
+// working as one implicit s3 destination.
+type UploadConfig struct {
+	S3Endpoint  string `json:"s3_endpoint"`
+	S3Bucket    string `json:"s3_bucket"`
+	S3AccessKey string `json:"s3_access_key"`
+	S3SecretKey string `json:"s3_secret_key"`
+	S3UseSSL    bool   `json:"s3_use_ssl"`
+	S3Prefix    string `json:"s3_prefix"`
+	WebhookURL  string `json:"webhook_url"`
+	// Destinations lists additional storage backends, e.g.
+	// [{"type": "sftp", "addr": "backup:22", "user": "crownpal",
+	//   "key_file": "~/.ssh/id_ed25519", "dir": "exports"}].
+	Destinations []StorageConfig `json:"destinations"`
+}
+
+// StorageConfig declares one storage destination; Type picks the backend
+// and decides which of the other fields matter.
+type StorageConfig struct {
+	Type string `json:"type"` // "local", "s3" or "sftp"
+	// local
+	Dir string `json:"dir"` // also the remote directory for sftp
+	// s3
+	Endpoint  string `json:"endpoint"`
+	Bucket    string `json:"bucket"`
+	AccessKey string `json:"access_key"`
+	SecretKey string `json:"secret_key"`
+	UseSSL    bool   `json:"use_ssl"`
+	Prefix    string `json:"prefix"`
+	// sftp
+	Addr     string `json:"addr"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	KeyFile  string `json:"key_file"`
+	HostKey  string `json:"host_key"`
+}
+
+// backend builds the storage.Backend a StorageConfig describes.
+func (c StorageConfig) backend() (storage.Backend, error) {
+	switch c.Type {
+	case "local":
+		return storage.Local{Dir: c.Dir}, nil
+	case "s3":
+		return storage.S3{
+			Endpoint:  c.Endpoint,
+			Bucket:    c.Bucket,
+			AccessKey: c.AccessKey,
+			SecretKey: c.SecretKey,
+			UseSSL:    c.UseSSL,
+			Prefix:    c.Prefix,
+		}, nil
+	case "sftp":
+		return storage.SFTP{
+			Addr:     c.Addr,
+			User:     c.User,
+			Password: c.Password,
+			KeyFile:  c.KeyFile,
+			HostKey:  c.HostKey,
+			Dir:      c.Dir,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown storage type %q (local, s3, sftp)", c.Type)
+	}
+}
+
+// storageBackends builds every configured backend: the legacy top-level
+// S3 fields first, then Destinations in order. Misconfigured entries are
+// reported once per call and skipped.
+func storageBackends() []storage.Backend {
+	up := config.Upload
+	var backends []storage.Backend
+	if up.S3Bucket != "" && up.S3Endpoint != "" {
+		backends = append(backends, storage.S3{
+			Endpoint:  up.S3Endpoint,
+			Bucket:    up.S3Bucket,
+			AccessKey: up.S3AccessKey,
+			SecretKey: up.S3SecretKey,
+			UseSSL:    up.S3UseSSL,
+			Prefix:    up.S3Prefix,
+		})
+	}
+	for _, dest := range up.Destinations {
+		b, err := dest.backend()
+		if err != nil {
+			fmt.Printf("⚠️  Skipping storage destination: %v\n", err)
+			continue
+		}
+		backends = append(backends, b)
+	}
+	return backends
+}
+
+// uploadExport pushes a finished artifact to every configured storage
+// backend and the webhook. Failures are reported but never abort the run.
+func uploadExport(path string) {
+	for _, backend := range storageBackends() {
+		ctx, cancel := context.WithTimeout(appCtx, 2*time.Minute)
+		err := backend.Put(ctx, path, filepath.Base(path))
+		cancel()
+		if err != nil {
+			fmt.Printf("⚠️  Upload to %s failed: %v\n", backend.Name(), err)
+		} else {
+			fmt.Printf("☁️  Uploaded %s to %s\n", filepath.Base(path), backend.Name())
+		}
+	}
+	if config.Upload.WebhookURL != "" {
+		if err := postToWebhook(path); err != nil {
+			fmt.Printf("⚠️  Webhook upload failed: %v\n", err)
+		} else {
+			fmt.Printf("☁️  Posted %s to webhook\n", filepath.Base(path))
+		}
+	}
+}
+
+func postToWebhook(path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(appCtx, "POST", config.Upload.WebhookURL, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentTypeFor(path))
+	req.Header.Set("X-Filename", filepath.Base(path))
+	resp, err := newHTTPClient(time.Minute).Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func contentTypeFor(path string) string {
+	if ct := mime.TypeByExtension(filepath.Ext(path)); ct != "" {
+		return ct
+	}
+	return "application/octet-stream"
+}
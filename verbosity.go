@@ -0,0 +1,28 @@
+package main
+
+import "fmt"
+
+// Verbosity levels. Normal prints one line per processed item, quiet only
+// prints summaries (essential when checking tens of thousands of emails),
+// verbose adds per-request detail for debugging providers.
+const (
+	verbosityQuiet = iota
+	verbosityNormal
+	verbosityVerbose
+)
+
+var verbosity = verbosityNormal
+
+// logItem prints per-item progress lines; suppressed by -q.
+func logItem(format string, args ...interface{}) {
+	if verbosity >= verbosityNormal {
+		fmt.Printf(format, args...)
+	}
+}
+
+// logVerbose prints debugging detail; shown only with -v.
+func logVerbose(format string, args ...interface{}) {
+	if verbosity >= verbosityVerbose {
+		fmt.Printf(format, args...)
+	}
+}
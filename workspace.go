@@ -0,0 +1,92 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// activeWorkspace keeps unrelated operations apart: every state file
+// (stock, store database, runs, autosave, scheduler) lives under the
+// workspace's own directory, its config.json overlays the base config,
+// and exports default into its output/ directory. Empty means the
+// classic shared layout.
+var activeWorkspace string
+
+// workspaceDir is the active workspace's root directory; only meaningful
+// while a workspace is active.
+func workspaceDir() string {
+	return ensureDir(filepath.Join(dataDir(), "workspaces", activeWorkspace))
+}
+
+// validWorkspaceName rejects names that would escape the workspaces tree.
+func validWorkspaceName(name string) bool {
+	return name == "" || (!strings.ContainsAny(name, `/\`) && name != "." && name != "..")
+}
+
+// listWorkspaces returns the workspaces that exist on disk.
+func listWorkspaces() []string {
+	entries, err := os.ReadDir(filepath.Join(dataDir(), "workspaces"))
+	if err != nil {
+		return nil
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() {
+			names = append(names, e.Name())
+		}
+	}
+	return names
+}
+
+// applyWorkspaceOverrides overlays the workspace's config.json (when one
+// exists) on the loaded base config, and defaults exports into the
+// workspace's output directory.
+func applyWorkspaceOverrides() {
+	if activeWorkspace == "" {
+		return
+	}
+	path := filepath.Join(workspaceDir(), configFile)
+	if data, err := os.ReadFile(path); err == nil {
+		if err := json.Unmarshal(data, &config); err != nil {
+			fmt.Printf("⚠️  Invalid %s: %v (ignoring overrides)\n", path, err)
+		}
+	}
+	if config.OutputDir == "" {
+		config.OutputDir = ensureDir(filepath.Join(workspaceDir(), "output"))
+	}
+}
+
+// switchWorkspace re-targets all state at another workspace: the current
+// stock is snapshotted and dropped (journal and expired list included, so
+// no undo can resurrect the old workspace's crowns), then config, ledgers
+// and the new workspace's stock load from the new location. A name that
+// never existed starts empty.
+func switchWorkspace(name string) {
+	if !validWorkspaceName(name) {
+		fmt.Printf("❌ Invalid workspace name %q\n", name)
+		return
+	}
+	if name == activeWorkspace {
+		return
+	}
+	saveStockSnapshot()
+	stockMu.Lock()
+	stock = nil
+	expiredStock = nil
+	stockMu.Unlock()
+	resetStockJournal()
+
+	activeWorkspace = name
+	loadConfig()
+	openKVCache()
+	loadConsumedLedger()
+	loadStockSnapshot()
+	if name == "" {
+		fmt.Println("✅ Workspace: default")
+		return
+	}
+	fmt.Printf("✅ Workspace: %s (state in %s)\n", name, workspaceDir())
+}